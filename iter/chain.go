@@ -1,8 +1,12 @@
 package iter
 
 // Chained is an Iterable that links two Iterables together sequentially.
+//
+// Each of the underlying iterators is dropped once exhausted, and never polled
+// again.
 type Chained[T any] struct {
-	a, b Iterable[T]
+	a, b         Iterable[T]
+	pullA, pullB func() (T, bool)
 }
 
 // Chain takes two iterators and creates a new iterator over both in sequence.
@@ -10,20 +14,152 @@ type Chained[T any] struct {
 // Chain will return a new iterator which will first iterate over values from
 // the first iterator and then over values from the second iterator.
 func Chain[T any](a, b Iterable[T]) *Chained[T] {
-	return &Chained[T]{a, b}
+	return &Chained[T]{a, b, valueFunc(a), valueFunc(b)}
 }
 
 // Next advances the iterator and returns the next value.
 //
 // Returns nil when iteration is finished.
 func (c *Chained[T]) Next() *T {
-	next := c.a.Next()
+	if c.a != nil {
+		if next := c.a.Next(); next != nil {
+			return next
+		}
+		c.a = nil
+	}
+
+	if c.b != nil {
+		if next := c.b.Next(); next != nil {
+			return next
+		}
+		c.b = nil
+	}
+
+	return nil
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (c *Chained[T]) NextValue() (T, bool) {
+	if c.a != nil {
+		if next, ok := c.pullA(); ok {
+			return next, true
+		}
+		c.a = nil
+	}
+
+	if c.b != nil {
+		if next, ok := c.pullB(); ok {
+			return next, true
+		}
+		c.b = nil
+	}
 
-	if next == nil {
-		return c.b.Next()
+	var zero T
+	return zero, false
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (c *Chained[T]) NextBack() *T {
+	if c.b != nil {
+		if next := back(c.b).NextBack(); next != nil {
+			return next
+		}
+		c.b = nil
 	}
 
-	return next
+	if c.a != nil {
+		if next := back(c.a).NextBack(); next != nil {
+			return next
+		}
+		c.a = nil
+	}
+
+	return nil
 }
 
-//go:generate go run ./cmd/gen/ -name Chained -output chain_ext_gen.go
+// DoubleEnded reports whether NextBack may be called, which is when both of the
+// remaining underlying iterators are double ended.
+func (c *Chained[T]) DoubleEnded() bool {
+	return (c.a == nil || IsDoubleEnded(c.a)) && (c.b == nil || IsDoubleEnded(c.b))
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (c *Chained[T]) SizeHint() (int, int, bool) {
+	hint := func(it Iterable[T]) (int, int, bool) {
+		if it == nil {
+			return 0, 0, true
+		}
+
+		return SizeHint(it)
+	}
+
+	loA, hiA, okA := hint(c.a)
+	loB, hiB, okB := hint(c.b)
+
+	return addHint(loA, hiA, okA, loB, hiB, okB)
+}
+
+func (c *Chained[T]) drain(n int) (int, bool) {
+	for _, it := range []Iterable[T]{c.a, c.b} {
+		if _, ok := drain(it, 0); it != nil && !ok {
+			return 0, false
+		}
+	}
+
+	count := 0
+	if c.a != nil {
+		count, _ = drain(c.a, n)
+		if count < n {
+			c.a = nil
+		}
+	}
+	if c.b != nil && count < n {
+		more, _ := drain(c.b, n-count)
+		if more < n-count {
+			c.b = nil
+		}
+		count += more
+	}
+
+	return count, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (c *Chained[T]) Fused() {}
+
+// Stop stops the remaining underlying iterators, if they are Stoppers.
+func (c *Chained[T]) Stop() {
+	if c.a != nil {
+		stop(c.a)
+	}
+	if c.b != nil {
+		stop(c.b)
+	}
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (c *Chained[T]) Clone() Iterable[T] {
+	var a, b Iterable[T]
+	if c.a != nil {
+		a = clone(c.a)
+	}
+	if c.b != nil {
+		b = clone(c.b)
+	}
+
+	return Chain(a, b)
+}
+
+// Cloneable reports whether Clone may be called, which is when both of the
+// remaining underlying iterators are cloneable.
+func (c *Chained[T]) Cloneable() bool {
+	return (c.a == nil || IsCloneable(c.a)) && (c.b == nil || IsCloneable(c.b))
+}
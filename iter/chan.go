@@ -0,0 +1,233 @@
+package iter
+
+import (
+	"context"
+	"sync"
+)
+
+// ChanIterator is an Iterable over the values received from a channel.
+type ChanIterator[T any] struct {
+	ch   <-chan T
+	stop func()
+	// interrupted is closed once any context given by WithContext is done, and
+	// interrupt closes it.
+	interrupted chan struct{}
+	interrupt   func()
+	// unregister undoes the registration of interrupt with each context.
+	unregister []func() bool
+	done       bool
+}
+
+// FromChan creates an iterator over the values received from ch.
+//
+// Each call to Next blocks until a value is received. Iteration is finished
+// when the channel is closed, or Stop is called. Values are only received as
+// they are requested, so an adapter such as Take reads no more from the channel
+// than it yields.
+//
+// Passed directly to WithContext, a blocked call to Next returns as soon as the
+// context is done.
+func FromChan[T any](ch <-chan T) *ChanIterator[T] {
+	return &ChanIterator[T]{ch: ch}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (c *ChanIterator[T]) Next() *T {
+	next, ok := c.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (c *ChanIterator[T]) NextValue() (T, bool) {
+	if c.done {
+		var zero T
+		return zero, false
+	}
+
+	var next T
+	var ok bool
+	if c.interrupted == nil {
+		next, ok = <-c.ch
+	} else {
+		select {
+		case next, ok = <-c.ch:
+		case <-c.interrupted:
+		}
+	}
+	if !ok {
+		c.Stop()
+	}
+
+	return next, ok
+}
+
+// setContext makes iteration finish once ctx is done, interrupting a blocked
+// receive. The goroutines started by FanIn are released as soon as it is done.
+// Each context given applies, so iteration finishes once the first is done.
+func (c *ChanIterator[T]) setContext(ctx context.Context) {
+	if c.done {
+		return
+	}
+
+	if c.interrupted == nil {
+		interrupted, stop := make(chan struct{}), c.stop
+		var once sync.Once
+		c.interrupted = interrupted
+		c.interrupt = func() {
+			once.Do(func() {
+				close(interrupted)
+				if stop != nil {
+					stop()
+				}
+			})
+		}
+	}
+
+	c.unregister = append(c.unregister, context.AfterFunc(ctx, c.interrupt))
+}
+
+// Stop ends iteration. No further values are received from the channel.
+//
+// Stop does not close or drain the channel, which remains owned by its sender.
+// For iterators created by FanIn, Stop also releases the goroutines forwarding
+// values from the input channels.
+func (c *ChanIterator[T]) Stop() {
+	if c.done {
+		return
+	}
+
+	c.done = true
+	for _, unregister := range c.unregister {
+		unregister()
+	}
+	c.unregister = nil
+
+	if c.stop != nil {
+		c.stop()
+	}
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The lower bound is the number of values buffered in the channel.
+func (c *ChanIterator[T]) SizeHint() (int, int, bool) {
+	if c.done {
+		return 0, 0, true
+	}
+
+	return len(c.ch), 0, false
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (c *ChanIterator[T]) Fused() {}
+
+// FanIn creates an iterator over the values received from all of chans, in the
+// order they arrive.
+//
+// Iteration is finished when every channel is closed, or Stop is called. Values
+// are forwarded by a goroutine per channel, which exits when its channel is
+// closed or Stop is called. Passed directly to WithContext, the goroutines are
+// also released once the context is done.
+func FanIn[T any](chans ...<-chan T) *ChanIterator[T] {
+	out := make(chan T)
+	quit := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case val, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- val:
+					case <-quit:
+						return
+					}
+				case <-quit:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	var once sync.Once
+	return &ChanIterator[T]{ch: out, stop: func() { once.Do(func() { close(quit) }) }}
+}
+
+// ToChan sends the elements of an iterator to a channel with a buffer of size
+// buf, from a new goroutine.
+//
+// The channel is closed once the iterator is exhausted. The returned stop
+// function abandons iteration: the goroutine stops the iterator, if it is a
+// Stopper, closes the channel and exits instead of blocking on its next send.
+// Consumers that may not read every value must call stop to avoid leaking the
+// goroutine. It is safe to call stop more than once.
+//
+// The iterator is owned by the goroutine until the channel is closed, and must
+// not be used by the caller in the meantime.
+func ToChan[T any](iter Iterable[T], buf int) (<-chan T, func()) {
+	quit := make(chan struct{})
+	out := toChan(iter, buf, quit)
+
+	var once sync.Once
+	return out, func() { once.Do(func() { close(quit) }) }
+}
+
+// ToChanCtx is like ToChan, but the goroutine abandons iteration once ctx is
+// done, as if stop had been called, rather than returning a stop function.
+func ToChanCtx[T any](ctx context.Context, iter Iterable[T], buf int) <-chan T {
+	return toChan(iter, buf, ctx.Done())
+}
+
+// toChan sends the elements of iter to a new channel from a new goroutine,
+// which abandons iteration once quit is closed.
+func toChan[T any](iter Iterable[T], buf int, quit <-chan struct{}) <-chan T {
+	out := make(chan T, buf)
+
+	go func() {
+		defer close(out)
+		pull := valueFunc(iter)
+		for {
+			// check quit first, as a send to a buffered channel may also be ready
+			select {
+			case <-quit:
+				stop(iter)
+				return
+			default:
+			}
+
+			next, ok := pull()
+			if !ok {
+				return
+			}
+
+			select {
+			case out <- next:
+			case <-quit:
+				stop(iter)
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,147 @@
+package iter_test
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleFromChan() {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for n := range 5 {
+			ch <- n
+		}
+	}()
+
+	fmt.Println(iter.FromChan(ch).Collect())
+	// Output:
+	// [0 1 2 3 4]
+}
+
+func ExampleToChan() {
+	ch, stop := iter.ToChan[int](iter.Range(0, 100), 0)
+	defer stop()
+
+	for n := range ch {
+		if n == 3 {
+			break
+		}
+		fmt.Println(n)
+	}
+	// Output:
+	// 0
+	// 1
+	// 2
+}
+
+func ExampleFanIn() {
+	a, _ := iter.ToChan[int](iter.New([]int{1, 2, 3}), 0)
+	b, _ := iter.ToChan[int](iter.New([]int{4, 5}), 0)
+
+	have := iter.FanIn(a, b).Collect()
+	slices.Sort(have)
+
+	fmt.Println(have)
+	// Output:
+	// [1 2 3 4 5]
+}
+
+func TestFromChan_take(t *testing.T) {
+	ch := make(chan int, 20)
+	for n := range 20 {
+		ch <- n
+	}
+
+	have := iter.Take[int](iter.FromChan(ch), 10).Collect()
+	if len(have) != 10 {
+		t.Errorf("Collect \n\thave %v\n\twant %v elements", have, 10)
+	}
+	if n := len(ch); n != 10 {
+		t.Errorf("remaining \n\thave %v\n\twant %v", n, 10)
+	}
+}
+
+func TestFromChan_stop(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 1
+	i := iter.FromChan(ch)
+
+	i.Stop()
+	if have := i.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if n := len(ch); n != 1 {
+		t.Errorf("remaining \n\thave %v\n\twant %v", n, 1)
+	}
+}
+
+func TestToChan_stop(t *testing.T) {
+	stopped := make(chan struct{})
+	src := iter.FromPull(func() (int, bool) { return 1, true }, func() { close(stopped) })
+
+	ch, stop := iter.ToChan[int](src, 4)
+	<-ch
+	stop()
+	stop()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatalf("expected stop to stop the source iterator")
+	}
+
+	// the channel is closed once the producer exits, possibly after any
+	// buffered values
+	for range ch {
+	}
+}
+
+func TestFanIn_stop(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+	go func() {
+		for {
+			select {
+			case a <- 1:
+			case b <- 2:
+			case <-time.After(100 * time.Millisecond):
+				close(a)
+				close(b)
+				return
+			}
+		}
+	}()
+
+	i := iter.Map(iter.FanIn(a, b), func(n int) int { return n * 10 })
+	have := iter.Take[int](i, 3).Collect()
+	if len(have) != 3 {
+		t.Errorf("Collect \n\thave %v\n\twant %v elements", have, 3)
+	}
+
+	i.Stop()
+	if next := i.Next(); next != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *next)
+	}
+}
+
+func TestFanIn_idle(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	// neither channel is ever sent on or closed
+	i := iter.FanIn(make(chan int), make(chan int))
+	i.Stop()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines \n\thave %v\n\twant %v", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -1,21 +1,70 @@
+// Command gen generates the fluent methods of every Iterable in a package.
+//
+// It parses the package with go/ast, finds each type with a method of the form
+// Next() *E, and writes the methods defined in adapter_ext.tmpl for it to a
+// file named after the source file declaring the type, eg map.go produces
+// map_ext_gen.go. Methods the type already declares by hand are skipped, as
+// are methods requiring a capability the type may lack, such as Rev.
+//
+// With -check, nothing is written; instead gen exits non-zero if any generated
+// file differs from what would be generated.
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"sort"
 	"strings"
 	"text/template"
 )
 
-type data struct {
-	InType  string
-	OutType string
-	Name    string
+const (
+	tmplName  = "adapter_ext.tmpl"
+	genSuffix = "_ext_gen.go"
+)
+
+// adapter describes an Iterable type for which fluent methods are generated.
+type adapter struct {
+	// Name is the name of the type.
+	Name string
+	// Recv is the type as it appears in the receiver of its Next method, eg
+	// Mapped[T, O].
+	Recv string
+	// Elem is the element type yielded by the iterator.
+	Elem string
+
+	file    string
+	methods map[string]bool
+}
+
+// Has reports whether the type declares the named method by hand.
+func (a *adapter) Has(method string) bool {
+	return a.methods[method]
+}
+
+// DoubleEnded reports whether the type is always double ended. Types declaring
+// a DoubleEnded method are only double ended when the iterators they adapt are,
+// so the reverse methods are not generated for them.
+func (a *adapter) DoubleEnded() bool {
+	return a.Has("NextBack") && !a.Has("DoubleEnded")
+}
+
+// Cloneable reports whether the type is always cloneable. As for DoubleEnded,
+// types declaring a Cloneable method may not be, so Cycle is not generated for
+// them.
+func (a *adapter) Cloneable() bool {
+	return a.Has("Clone") && !a.Has("Cloneable")
 }
 
 func handleErr(err error) {
@@ -25,36 +74,271 @@ func handleErr(err error) {
 }
 
 func main() {
-	var d data
-
-	flag.StringVar(&d.InType, "itype", "T", "The type alias for the iterators contained values.")
-	flag.StringVar(&d.OutType, "otype", "T", "The type alias for the iterators output value, if it differs from the contained value")
-	flag.StringVar(&d.Name, "name", "", "The name used for the adapter type being generated. This should start with a capital letter so that it is exported.")
-	fname := flag.String("output", fmt.Sprintf("%v_ext_gen.go", strings.ToLower(d.Name)), "The output file name")
+	dir := flag.String("dir", ".", "The directory of the package to generate fluent methods for.")
+	check := flag.Bool("check", false, "Report generated files that differ from what would be generated, without writing them, and exit non-zero if there are any.")
 	flag.Parse()
 
-	if d.InType != d.OutType {
-		d.InType = fmt.Sprintf("%v, %v", d.InType, d.OutType)
-	}
-
-	const tmplName = "adapter_ext.tmpl"
+	adapters, pkg, err := parsePackage(*dir)
+	handleErr(err)
 
 	_, callFile, _, _ := runtime.Caller(0)
-	path := filepath.Dir(callFile)
-	t := template.Must(template.New(tmplName).ParseFiles(path + "/" + tmplName))
+	tmplPath := filepath.Join(filepath.Dir(callFile), tmplName)
+	t := template.Must(template.New(tmplName).ParseFiles(tmplPath))
+	methods, err := methodOrder(tmplPath)
+	handleErr(err)
 
-	file, err := os.Create(*fname)
+	files, err := generate(t, methods, pkg, adapters)
 	handleErr(err)
-	defer file.Close()
 
-	gen := new(strings.Builder)
+	if *check {
+		drift, err := checkFiles(*dir, files)
+		handleErr(err)
 
-	// execute template
-	handleErr(t.Execute(gen, d))
+		for _, name := range drift {
+			fmt.Fprintf(os.Stderr, "%v is out of date\n", name)
+		}
+		if len(drift) != 0 {
+			os.Exit(1)
+		}
 
-	formatted, err := format.Source([]byte(gen.String()))
-	handleErr(err)
+		return
+	}
+
+	for name, src := range files {
+		handleErr(os.WriteFile(filepath.Join(*dir, name), src, 0o644))
+	}
 
-	_, err = file.Write(formatted)
+	// remove files for adapters that no longer exist
+	existing, err := filepath.Glob(filepath.Join(*dir, "*"+genSuffix))
 	handleErr(err)
+	for _, path := range existing {
+		if _, ok := files[filepath.Base(path)]; !ok {
+			handleErr(os.Remove(path))
+		}
+	}
+}
+
+// parsePackage finds the Iterable types declared in the non-test, non-generated
+// files of the package in dir.
+func parsePackage(dir string) ([]*adapter, string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	fset := token.NewFileSet()
+	specs := map[string]*ast.TypeSpec{}
+	declaredIn := map[string]string{}
+	methods := map[string]map[string]bool{}
+	elems := map[string]string{}
+	recvs := map[string]string{}
+	pkg := ""
+
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, genSuffix) {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, "", err
+		}
+		pkg = f.Name.Name
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						specs[ts.Name.Name] = ts
+						declaredIn[ts.Name.Name] = name
+					}
+				}
+			case *ast.FuncDecl:
+				recv := receiverName(decl)
+				if recv == "" {
+					continue
+				}
+
+				if methods[recv] == nil {
+					methods[recv] = map[string]bool{}
+				}
+				methods[recv][decl.Name.Name] = true
+
+				if elem, ok := nextElem(decl); ok {
+					elems[recv] = elem
+					recvs[recv] = types.ExprString(receiverType(decl))
+				}
+			}
+		}
+	}
+
+	var adapters []*adapter
+	for name, elem := range elems {
+		if _, ok := specs[name]; !ok {
+			continue
+		}
+
+		adapters = append(adapters, &adapter{
+			Name:    name,
+			Recv:    recvs[name],
+			Elem:    elem,
+			file:    declaredIn[name],
+			methods: methods[name],
+		})
+	}
+
+	// order by position in the source, so output is deterministic
+	sort.Slice(adapters, func(i, j int) bool {
+		return specs[adapters[i].Name].Pos() < specs[adapters[j].Name].Pos()
+	})
+
+	return adapters, pkg, nil
+}
+
+// receiverType returns the type a method is declared on, as it appears in the
+// receiver, or nil if decl is a function.
+func receiverType(decl *ast.FuncDecl) ast.Expr {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return nil
+	}
+
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	return expr
+}
+
+// receiverName returns the name of the type a method is declared on, or "" if
+// decl is a function.
+func receiverName(decl *ast.FuncDecl) string {
+	expr := receiverType(decl)
+
+	switch generic := expr.(type) {
+	case *ast.IndexExpr:
+		expr = generic.X
+	case *ast.IndexListExpr:
+		expr = generic.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// nextElem reports whether decl is an Iterable Next method, ie Next() *E, and
+// returns E.
+func nextElem(decl *ast.FuncDecl) (string, bool) {
+	typ := decl.Type
+	if decl.Name.Name != "Next" || typ.Params.NumFields() != 0 || typ.Results.NumFields() != 1 {
+		return "", false
+	}
+
+	star, ok := typ.Results.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+
+	return types.ExprString(star.X), true
+}
+
+// methodOrder returns the names of the method templates in the template file,
+// in the order they are defined.
+func methodOrder(path string) ([]string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	for _, match := range regexp.MustCompile(`{{define "(\w+)"}}`).FindAllSubmatch(src, -1) {
+		if name := string(match[1]); name != "header" {
+			methods = append(methods, name)
+		}
+	}
+
+	return methods, nil
+}
+
+// generate executes the method templates for each adapter, returning the
+// formatted source of each generated file by name.
+func generate(t *template.Template, methods []string, pkg string, adapters []*adapter) (map[string][]byte, error) {
+	bodies := map[string]*strings.Builder{}
+	var order []string
+
+	for _, a := range adapters {
+		name := strings.TrimSuffix(a.file, ".go") + genSuffix
+		body, ok := bodies[name]
+		if !ok {
+			body = new(strings.Builder)
+			bodies[name] = body
+			order = append(order, name)
+		}
+
+		for _, method := range methods {
+			if a.Has(method) {
+				continue
+			}
+			if err := t.ExecuteTemplate(body, method, a); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	files := map[string][]byte{}
+	for _, name := range order {
+		body := bodies[name].String()
+		header := struct {
+			Package string
+			Seq     bool
+		}{pkg, strings.Contains(body, "stditer.")}
+
+		gen := new(strings.Builder)
+		if err := t.ExecuteTemplate(gen, "header", header); err != nil {
+			return nil, err
+		}
+		gen.WriteString(body)
+
+		formatted, err := format.Source([]byte(gen.String()))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", name, err)
+		}
+		files[name] = formatted
+	}
+
+	return files, nil
+}
+
+// checkFiles compares the generated files to those in dir, returning the names
+// of any that differ, are missing, or would no longer be generated.
+func checkFiles(dir string, files map[string][]byte) ([]string, error) {
+	var drift []string
+
+	for name, want := range files {
+		have, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if !bytes.Equal(have, want) {
+			drift = append(drift, name)
+		}
+	}
+
+	existing, err := filepath.Glob(filepath.Join(dir, "*"+genSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range existing {
+		if _, ok := files[filepath.Base(path)]; !ok {
+			drift = append(drift, filepath.Base(path))
+		}
+	}
+
+	sort.Strings(drift)
+	return drift, nil
 }
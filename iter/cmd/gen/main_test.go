@@ -0,0 +1,90 @@
+package main
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+	"text/template"
+)
+
+func TestGenerated(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	dir := filepath.Join(filepath.Dir(file), "..", "..")
+	tmplPath := filepath.Join(filepath.Dir(file), tmplName)
+
+	adapters, pkg, err := parsePackage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods, err := methodOrder(tmplPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := template.Must(template.New(tmplName).ParseFiles(tmplPath))
+
+	files, err := generate(tmpl, methods, pkg, adapters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drift, err := checkFiles(dir, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range drift {
+		t.Errorf("%v is out of date, run go generate", name)
+	}
+}
+
+func TestParsePackage(t *testing.T) {
+	_, file, _, _ := runtime.Caller(0)
+	dir := filepath.Join(filepath.Dir(file), "..", "..")
+
+	adapters, pkg, err := parsePackage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg != "iter" {
+		t.Errorf("package \n\thave %v\n\twant iter", pkg)
+	}
+
+	byName := map[string]*adapter{}
+	for _, a := range adapters {
+		byName[a.Name] = a
+	}
+
+	cases := []struct {
+		name, recv, elem string
+	}{
+		{"Iterator", "Iterator[T]", "T"},
+		{"Mapped", "Mapped[T, O]", "O"},
+		{"Flat", "Flat[T]", "T"},
+		{"Enumerated", "Enumerated[T]", "Pair[int, T]"},
+	}
+	for _, c := range cases {
+		a, ok := byName[c.name]
+		if !ok {
+			t.Errorf("%v was not found", c.name)
+			continue
+		}
+		if a.Recv != c.recv || a.Elem != c.elem {
+			t.Errorf("%v \n\thave %v %v\n\twant %v %v", c.name, a.Recv, a.Elem, c.recv, c.elem)
+		}
+	}
+
+	// fallible iterators do not have an Iterable Next method
+	if _, ok := byName["TryMapped"]; ok {
+		t.Errorf("TryMapped should not be treated as an Iterable")
+	}
+	if !byName["Iterator"].Has("Rev") || byName["Mapped"].Has("Rev") {
+		t.Errorf("expected only hand written methods to be reported")
+	}
+
+	// adapters are double ended only when their inputs are
+	if !byName["Iterator"].DoubleEnded() || byName["Mapped"].DoubleEnded() {
+		t.Errorf("expected only sources to be always double ended")
+	}
+	if !byName["Iterator"].Cloneable() || byName["Mapped"].Cloneable() {
+		t.Errorf("expected only sources to be always cloneable")
+	}
+}
@@ -0,0 +1,336 @@
+package iter
+
+import (
+	"math"
+	"math/bits"
+)
+
+// remaining tracks the number of elements left in an iterator whose total may
+// be too large to count with an int.
+type remaining struct {
+	n     int
+	exact bool
+}
+
+// next records that an element has been yielded.
+func (r *remaining) next() {
+	if r.exact {
+		r.n -= 1
+	}
+}
+
+// sizeHint returns the bounds on the remaining length of the iterator.
+func (r *remaining) sizeHint() (int, int, bool) {
+	if !r.exact {
+		return math.MaxInt, 0, false
+	}
+
+	return r.n, r.n, true
+}
+
+// mulCount multiplies a by b, reporting whether the result fits in an int.
+func mulCount(a, b int) (int, bool) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 || lo > math.MaxInt {
+		return 0, false
+	}
+
+	return int(lo), true
+}
+
+// binomial returns the number of ways to choose k of n items, reporting whether
+// the result fits in an int.
+func binomial(n, k int) remaining {
+	if k > n {
+		return remaining{0, true}
+	}
+	k = min(k, n-k)
+
+	c := uint64(1)
+	for i := 0; i < k; i++ {
+		// c * (n-i) is always divisible by i+1
+		hi, lo := bits.Mul64(c, uint64(n-i))
+		if hi >= uint64(i+1) {
+			return remaining{}
+		}
+		c, _ = bits.Div64(hi, lo, uint64(i+1))
+	}
+	if c > math.MaxInt {
+		return remaining{}
+	}
+
+	return remaining{int(c), true}
+}
+
+// PermutationIterator is an Iterable over the ordered arrangements of elements
+// of a slice.
+type PermutationIterator[T any] struct {
+	items []T
+	// idx holds the indices of the items in the current arrangement.
+	idx []int
+	// used reports whether each item appears in the current arrangement.
+	used    []bool
+	started bool
+	done    bool
+	rem     remaining
+}
+
+// Permutations creates an iterator over the arrangements of k distinct
+// elements of items, in lexicographic order of their positions in items.
+//
+// Each arrangement is a new slice. Elements at different positions are
+// distinct, even if they are equal. If k is greater than the number of items
+// the iterator is empty, and if k is zero it yields a single empty slice.
+//
+// Panics if k is negative.
+func Permutations[T any](items []T, k int) *PermutationIterator[T] {
+	if k < 0 {
+		panic("Permutations k must not be negative")
+	}
+
+	n := len(items)
+	rem := remaining{1, true}
+	if k > n {
+		rem.n = 0
+	}
+	for i := 0; i < k && rem.exact && rem.n > 0; i++ {
+		rem.n, rem.exact = mulCount(rem.n, n-i)
+	}
+
+	return &PermutationIterator[T]{
+		items: items,
+		idx:   make([]int, k),
+		used:  make([]bool, n),
+		done:  k > n,
+		rem:   rem,
+	}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *PermutationIterator[T]) Next() *[]T {
+	next, ok := p.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *PermutationIterator[T]) NextValue() ([]T, bool) {
+	if p.done || !p.advance() {
+		p.done = true
+		return nil, false
+	}
+
+	p.rem.next()
+	return pick(p.items, p.idx), true
+}
+
+// advance moves to the next arrangement, reporting whether there is one.
+func (p *PermutationIterator[T]) advance() bool {
+	if !p.started {
+		p.started = true
+		p.fill(0)
+		return true
+	}
+
+	for i := len(p.idx) - 1; i >= 0; i-- {
+		p.used[p.idx[i]] = false
+
+		// replace with the smallest larger unused item, if any
+		for j := p.idx[i] + 1; j < len(p.items); j++ {
+			if !p.used[j] {
+				p.idx[i] = j
+				p.used[j] = true
+				p.fill(i + 1)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fill sets the positions of the arrangement from i onwards to the smallest
+// unused items, in order.
+func (p *PermutationIterator[T]) fill(i int) {
+	for j := 0; i < len(p.idx); j++ {
+		if !p.used[j] {
+			p.idx[i] = j
+			p.used[j] = true
+			i += 1
+		}
+	}
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (p *PermutationIterator[T]) SizeHint() (int, int, bool) {
+	return p.rem.sizeHint()
+}
+
+// Len returns the number of elements remaining in the iterator.
+//
+// Panics if the number of permutations is too large to be counted by an int.
+func (p *PermutationIterator[T]) Len() int {
+	return lenOf[[]T](p)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (p *PermutationIterator[T]) Fused() {}
+
+// CombinationIterator is an Iterable over the selections of elements of a
+// slice, without regard to order.
+type CombinationIterator[T any] struct {
+	items   []T
+	idx     []int
+	replace bool
+	started bool
+	done    bool
+	rem     remaining
+}
+
+// Combinations creates an iterator over the selections of k elements of items,
+// without replacement, in lexicographic order of their positions in items.
+//
+// Each selection is a new slice, with its elements in the order they appear in
+// items. Elements at different positions are distinct, even if they are equal.
+// If k is greater than the number of items the iterator is empty, and if k is
+// zero it yields a single empty slice.
+//
+// Panics if k is negative.
+func Combinations[T any](items []T, k int) *CombinationIterator[T] {
+	if k < 0 {
+		panic("Combinations k must not be negative")
+	}
+
+	return &CombinationIterator[T]{
+		items: items,
+		idx:   make([]int, k),
+		done:  k > len(items),
+		rem:   binomial(len(items), k),
+	}
+}
+
+// CombinationsWithReplacement creates an iterator over the selections of k
+// elements of items, where each element may be selected more than once, in
+// lexicographic order of their positions in items.
+//
+// Each selection is a new slice, with its elements in the order they appear in
+// items. If items is empty and k is not zero, the iterator is empty.
+//
+// Panics if k is negative.
+func CombinationsWithReplacement[T any](items []T, k int) *CombinationIterator[T] {
+	if k < 0 {
+		panic("CombinationsWithReplacement k must not be negative")
+	}
+
+	n := len(items)
+	rem := remaining{1, true}
+	if k > 0 {
+		rem = binomial(n+k-1, k)
+	}
+
+	return &CombinationIterator[T]{
+		items:   items,
+		idx:     make([]int, k),
+		replace: true,
+		done:    n == 0 && k > 0,
+		rem:     rem,
+	}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (c *CombinationIterator[T]) Next() *[]T {
+	next, ok := c.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (c *CombinationIterator[T]) NextValue() ([]T, bool) {
+	if c.done || !c.advance() {
+		c.done = true
+		return nil, false
+	}
+
+	c.rem.next()
+	return pick(c.items, c.idx), true
+}
+
+// advance moves to the next selection, reporting whether there is one.
+func (c *CombinationIterator[T]) advance() bool {
+	n, k := len(c.items), len(c.idx)
+
+	if !c.started {
+		c.started = true
+		if !c.replace {
+			for i := range c.idx {
+				c.idx[i] = i
+			}
+		}
+		return true
+	}
+
+	// find the rightmost position that can be incremented
+	for i := k - 1; i >= 0; i-- {
+		last := n - k + i
+		if c.replace {
+			last = n - 1
+		}
+		if c.idx[i] == last {
+			continue
+		}
+
+		c.idx[i] += 1
+		for j := i + 1; j < k; j++ {
+			if c.replace {
+				c.idx[j] = c.idx[i]
+			} else {
+				c.idx[j] = c.idx[j-1] + 1
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (c *CombinationIterator[T]) SizeHint() (int, int, bool) {
+	return c.rem.sizeHint()
+}
+
+// Len returns the number of elements remaining in the iterator.
+//
+// Panics if the number of combinations is too large to be counted by an int.
+func (c *CombinationIterator[T]) Len() int {
+	return lenOf[[]T](c)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (c *CombinationIterator[T]) Fused() {}
+
+// pick returns a new slice of the items at the indices idx.
+func pick[T any](items []T, idx []int) []T {
+	out := make([]T, len(idx))
+	for i, j := range idx {
+		out[i] = items[j]
+	}
+
+	return out
+}
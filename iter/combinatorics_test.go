@@ -0,0 +1,122 @@
+package iter_test
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExamplePermutations() {
+	fmt.Println(iter.Permutations([]string{"a", "b", "c"}, 2).Collect())
+	// Output:
+	// [[a b] [a c] [b a] [b c] [c a] [c b]]
+}
+
+func ExampleCombinations() {
+	fmt.Println(iter.Combinations([]int{1, 2, 3, 4}, 2).Collect())
+	// Output:
+	// [[1 2] [1 3] [1 4] [2 3] [2 4] [3 4]]
+}
+
+func ExampleCombinationsWithReplacement() {
+	fmt.Println(iter.CombinationsWithReplacement([]string{"x", "y"}, 3).Collect())
+	// Output:
+	// [[x x x] [x x y] [x y y] [y y y]]
+}
+
+func ExampleCombinationIterator_Len() {
+	c := iter.Combinations(make([]int, 10), 3)
+	c.Take(100).Count()
+
+	fmt.Println(c.Len())
+	// Output:
+	// 20
+}
+
+func TestCombinatorics_counts(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4}
+	tests := map[string]struct {
+		it   iter.Iterable[[]int]
+		want int
+	}{
+		"perm 5 0":  {iter.Permutations(items, 0), 1},
+		"perm 5 3":  {iter.Permutations(items, 3), 60},
+		"perm 5 5":  {iter.Permutations(items, 5), 120},
+		"perm 5 6":  {iter.Permutations(items, 6), 0},
+		"perm 0 0":  {iter.Permutations([]int{}, 0), 1},
+		"comb 5 0":  {iter.Combinations(items, 0), 1},
+		"comb 5 2":  {iter.Combinations(items, 2), 10},
+		"comb 5 5":  {iter.Combinations(items, 5), 1},
+		"comb 5 6":  {iter.Combinations(items, 6), 0},
+		"combr 5 0": {iter.CombinationsWithReplacement(items, 0), 1},
+		"combr 5 3": {iter.CombinationsWithReplacement(items, 3), 35},
+		"combr 0 2": {iter.CombinationsWithReplacement([]int{}, 2), 0},
+		"combr 0 0": {iter.CombinationsWithReplacement([]int{}, 0), 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lower, upper, ok := iter.SizeHint(tc.it)
+			if lower != tc.want || upper != tc.want || !ok {
+				t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, tc.want, tc.want, true)
+			}
+
+			seen := map[string]bool{}
+			for next := tc.it.Next(); next != nil; next = tc.it.Next() {
+				seen[fmt.Sprint(*next)] = true
+			}
+			if len(seen) != tc.want {
+				t.Errorf("distinct \n\thave %v\n\twant %v", len(seen), tc.want)
+			}
+			if n := iter.Count(tc.it); n != 0 {
+				t.Errorf("Count after exhaustion \n\thave %v\n\twant %v", n, 0)
+			}
+		})
+	}
+}
+
+func TestCombinatorics_lexicographic(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4}
+	tests := map[string]iter.Iterable[[]int]{
+		"perm":  iter.Permutations(items, 3),
+		"comb":  iter.Combinations(items, 3),
+		"combr": iter.CombinationsWithReplacement(items, 3),
+	}
+
+	for name, it := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := iter.Collect(it)
+			if !slices.IsSortedFunc(have, slices.Compare[[]int]) {
+				t.Errorf("Collect not in lexicographic order: %v", have)
+			}
+		})
+	}
+}
+
+func TestCombinatorics_newSlices(t *testing.T) {
+	c := iter.Combinations([]int{1, 2, 3}, 2)
+	first := *c.Next()
+	c.Next()
+
+	if want := []int{1, 2}; !slices.Equal(first, want) {
+		t.Errorf("Next \n\thave %v\n\twant %v", first, want)
+	}
+}
+
+func TestCombinatorics_overflow(t *testing.T) {
+	p := iter.Permutations(make([]int, 30), 30)
+	if lower, upper, ok := p.SizeHint(); lower != math.MaxInt || upper != 0 || ok {
+		t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, math.MaxInt, 0, false)
+	}
+
+	// C(68, 34) overflows an int, but C(62, 31) does not
+	if _, _, ok := iter.Combinations(make([]int, 68), 34).SizeHint(); ok {
+		t.Errorf("SizeHint of C(68, 34) \n\thave exact\n\twant inexact")
+	}
+	if n := iter.Combinations(make([]int, 62), 31).Len(); n != 465428353255261088 {
+		t.Errorf("Len \n\thave %v\n\twant %v", n, 465428353255261088)
+	}
+}
@@ -0,0 +1,172 @@
+package iter
+
+import "context"
+
+// Contextual is an Iterable that ends once its context is done.
+type Contextual[T any] struct {
+	ctx  context.Context
+	iter Iterable[T]
+	pull func() (T, bool)
+	err  error
+}
+
+// WithContext creates an iterator that ends once ctx is done.
+//
+// The context is checked before each element is pulled from the underlying
+// iterator; an element already being produced is not interrupted. When
+// iteration ends due to the context, the underlying iterator is stopped if it
+// is a Stopper, releasing any goroutines or other resources it holds.
+//
+// Sources which block waiting for an element, such as those created by FromChan
+// and FanIn, are interrupted once ctx is done when passed to WithContext
+// directly, or through other calls to WithContext. Apply WithContext to such a
+// source before adapting it, eg Map(WithContext(ctx, FromChan(ch)), fn), rather
+// than to the adapter.
+func WithContext[T any](ctx context.Context, iter Iterable[T]) *Contextual[T] {
+	if c, ok := iter.(contextSetter); ok {
+		c.setContext(ctx)
+	}
+
+	return &Contextual[T]{ctx, iter, valueFunc(iter), nil}
+}
+
+// contextSetter is implemented by sources which may block waiting for an
+// element, so that WithContext can interrupt them.
+type contextSetter interface {
+	setContext(ctx context.Context)
+}
+
+// setContext passes ctx on to the underlying iterator, so that a source
+// wrapped by nested calls to WithContext is interrupted by each context.
+func (c *Contextual[T]) setContext(ctx context.Context) {
+	if s, ok := c.iter.(contextSetter); ok {
+		s.setContext(ctx)
+	}
+}
+
+// done reports whether the context is done, stopping the underlying iterator
+// the first time it is.
+func (c *Contextual[T]) done() bool {
+	if c.err != nil {
+		return true
+	}
+
+	if err := c.ctx.Err(); err != nil {
+		c.err = err
+		stop(c.iter)
+
+		return true
+	}
+
+	return false
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or the context is done.
+func (c *Contextual[T]) Next() *T {
+	if c.done() {
+		return nil
+	}
+
+	next := c.iter.Next()
+	if next == nil {
+		// the source may have been interrupted by the context
+		c.done()
+	}
+
+	return next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished, or the context
+// is done.
+func (c *Contextual[T]) NextValue() (T, bool) {
+	if c.done() {
+		var zero T
+		return zero, false
+	}
+
+	next, ok := c.pull()
+	if !ok {
+		// the source may have been interrupted by the context
+		c.done()
+	}
+
+	return next, ok
+}
+
+// Err returns the context's error if iteration ended because the context was
+// done, and nil otherwise.
+func (c *Contextual[T]) Err() error {
+	return c.err
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (c *Contextual[T]) Stop() {
+	stop(c.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The lower bound is always 0, as the context may be done at any time.
+func (c *Contextual[T]) SizeHint() (int, int, bool) {
+	if c.err != nil {
+		return 0, 0, true
+	}
+
+	_, upper, ok := SizeHint(c.iter)
+	return 0, upper, ok
+}
+
+// CollectCtx transforms an iterator into a slice, stopping early if ctx is
+// done.
+//
+// The elements collected before the context was done are returned along with
+// the context's error.
+func CollectCtx[T any](ctx context.Context, iter Iterable[T]) ([]T, error) {
+	c := WithContext(ctx, iter)
+	out := Collect[T](c)
+
+	return out, c.Err()
+}
+
+// ForEachCtx calls a function on each element of an iterator, stopping early
+// if ctx is done.
+//
+// Returns the context's error if it was done before the iterator was
+// exhausted.
+func ForEachCtx[T any](ctx context.Context, iter Iterable[T], fn func(T)) error {
+	c := WithContext(ctx, iter)
+	ForEach[T](c, fn)
+
+	return c.Err()
+}
+
+// FoldCtx repeatedly applies a reducing operation, reducing the iterator to a
+// single element, stopping early if ctx is done.
+//
+// The value accumulated before the context was done is returned along with the
+// context's error.
+func FoldCtx[T any, O any](ctx context.Context, iter Iterable[T], init O, fn func(O, T) O) (O, error) {
+	c := WithContext(ctx, iter)
+	accum := Fold[T](c, init, fn)
+
+	return accum, c.Err()
+}
+
+// FindCtx searches for an element of an iterator that satisfies a predicate,
+// stopping early if ctx is done.
+//
+// Returns nil and the context's error if it was done before a matching element
+// was found.
+func FindCtx[T any](ctx context.Context, iter Iterable[T], pred func(T) bool) (*T, error) {
+	c := WithContext(ctx, iter)
+	if found := Find[T](c, pred); found != nil {
+		return found, nil
+	}
+
+	return nil, c.Err()
+}
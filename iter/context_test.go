@@ -0,0 +1,171 @@
+package iter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleWithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	i := iter.WithContext[int](ctx, iter.New([]int{1, 2, 3, 4}))
+
+	fmt.Println(*i.Next())
+	cancel()
+	fmt.Println(i.Next())
+	fmt.Println(i.Err())
+	// Output:
+	// 1
+	// <nil>
+	// context canceled
+}
+
+func ExampleCollectCtx() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelAt3 := func(n int) int {
+		if n == 3 {
+			cancel()
+		}
+		return n
+	}
+	m := iter.Map[int, int](iter.New([]int{1, 2, 3, 4, 5}), cancelAt3)
+
+	fmt.Println(iter.CollectCtx[int](ctx, m))
+	// Output:
+	// [1 2 3] context canceled
+}
+
+func TestContextConsumers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	list := []int{1, 2, 3}
+	isTwo := func(n int) bool { return n == 2 }
+
+	if _, err := iter.CollectCtx[int](ctx, iter.New(list)); !errors.Is(err, context.Canceled) {
+		t.Errorf("CollectCtx error \n\thave %v\n\twant %v", err, context.Canceled)
+	}
+	if err := iter.ForEachCtx[int](ctx, iter.New(list), func(int) {}); !errors.Is(err, context.Canceled) {
+		t.Errorf("ForEachCtx error \n\thave %v\n\twant %v", err, context.Canceled)
+	}
+	if _, err := iter.FoldCtx[int](ctx, iter.New(list), 0, func(a, b int) int { return a + b }); !errors.Is(err, context.Canceled) {
+		t.Errorf("FoldCtx error \n\thave %v\n\twant %v", err, context.Canceled)
+	}
+	if found, err := iter.FindCtx[int](ctx, iter.New(list), isTwo); found != nil || !errors.Is(err, context.Canceled) {
+		t.Errorf("FindCtx \n\thave %v, %v\n\twant <nil>, %v", found, err, context.Canceled)
+	}
+
+	// a live context reports no error
+	found, err := iter.FindCtx[int](context.Background(), iter.New(list), isTwo)
+	if found == nil || *found != 2 || err != nil {
+		t.Errorf("FindCtx \n\thave %v, %v\n\twant 2, <nil>", found, err)
+	}
+	sum, err := iter.FoldCtx[int](context.Background(), iter.New(list), 0, func(a, b int) int { return a + b })
+	if sum != 6 || err != nil {
+		t.Errorf("FoldCtx \n\thave %v, %v\n\twant 6, <nil>", sum, err)
+	}
+}
+
+func TestWithContext_stops(t *testing.T) {
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for n := 0; yield(n); n++ {
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	i := iter.WithContext[int](ctx, iter.FromSeq(seq))
+	i.Next()
+	cancel()
+
+	if have := i.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if !stopped {
+		t.Errorf("expected cancellation to stop the underlying sequence")
+	}
+}
+
+func TestCollectCtx_chan(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// the channel is never sent on or closed, so only the context ends iteration
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		have, err := iter.CollectCtx[int](ctx, iter.FromChan(make(chan int)))
+		if len(have) != 0 || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("CollectCtx \n\thave %v, %v\n\twant [], %v", have, err, context.DeadlineExceeded)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected CollectCtx to return once the context is done")
+	}
+}
+
+func TestWithContext_fanIn(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	iter.WithContext[int](ctx, iter.FanIn(make(chan int), make(chan int)))
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutines \n\thave %v\n\twant %v", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestToChanCtx(t *testing.T) {
+	stopped := make(chan struct{})
+	src := iter.FromPull(func() (int, bool) { return 1, true }, func() { close(stopped) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := iter.ToChanCtx[int](ctx, src, 0)
+	<-ch
+	cancel()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatalf("expected cancellation to stop the source iterator")
+	}
+
+	for range ch {
+	}
+}
+
+func TestWithContext_nested(t *testing.T) {
+	outer, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// only the outer context is ever done
+	inner := iter.WithContext[int](context.Background(), iter.FromChan(make(chan int)))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		have, err := iter.CollectCtx[int](outer, inner)
+		if len(have) != 0 || !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("CollectCtx \n\thave %v, %v\n\twant [], %v", have, err, context.DeadlineExceeded)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("expected CollectCtx to return once the outer context is done")
+	}
+}
@@ -0,0 +1,125 @@
+package iter
+
+import "math"
+
+// Cloner is an Iterable able to duplicate itself, including its position.
+//
+// Adapters such as Map declare Clone whatever the iterators they adapt, but are
+// only able to clone themselves when those iterators are. They report whether
+// they are with a Cloneable method; use IsCloneable to check any iterator.
+// Functions held by an adapter, such as a mapping function or predicate, are
+// shared between the clones rather than copied.
+type Cloner[T any] interface {
+	Iterable[T]
+	// Clone returns an independent copy of the iterator, positioned at the
+	// same element.
+	Clone() Iterable[T]
+}
+
+// IsCloneable reports whether iter is able to clone itself.
+//
+// An iterator is cloneable if it is a Cloner and, if it has a Cloneable method,
+// that method reports true.
+func IsCloneable[T any](iter Iterable[T]) bool {
+	if _, ok := iter.(Cloner[T]); !ok {
+		return false
+	}
+	if c, ok := iter.(interface{ Cloneable() bool }); ok {
+		return c.Cloneable()
+	}
+
+	return true
+}
+
+// clone asserts that iter is a Cloner and clones it, panicking if it is not.
+func clone[T any](iter Iterable[T]) Iterable[T] {
+	c, ok := iter.(Cloner[T])
+	if !ok {
+		panic("Clone requires a Cloner")
+	}
+
+	return c.Clone()
+}
+
+// Cycled is an Iterable that repeats endlessly.
+type Cycled[T any] struct {
+	orig, iter Iterable[T]
+	pull       func() (T, bool)
+}
+
+// Cycle repeats an iterator endlessly.
+//
+// Instead of stopping when the iterator is exhausted, Cycle will start again,
+// from a clone of the iterator as it was when Cycle was called. If the
+// iterator is empty, so is the cycle.
+//
+// Panics if the iterator is not cloneable; see IsCloneable.
+func Cycle[T any](iter Cloner[T]) *Cycled[T] {
+	if !IsCloneable[T](iter) {
+		panic("Cycle requires a cloneable iterator")
+	}
+
+	return &Cycled[T]{iter.Clone(), iter, valueFunc[T](iter)}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, which only happens when the original
+// iterator is empty.
+func (c *Cycled[T]) Next() *T {
+	if next := c.iter.Next(); next != nil {
+		return next
+	}
+
+	c.restart()
+	return c.iter.Next()
+}
+
+// restart begins a new pass, from a clone of the original iterator.
+func (c *Cycled[T]) restart() {
+	c.iter = clone(c.orig)
+	c.pull = valueFunc(c.iter)
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (c *Cycled[T]) NextValue() (T, bool) {
+	if next, ok := c.pull(); ok {
+		return next, true
+	}
+
+	c.restart()
+	return c.pull()
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// A cycle is unbounded unless the original iterator is empty.
+func (c *Cycled[T]) SizeHint() (int, int, bool) {
+	lower, upper, ok := SizeHint(c.orig)
+	if ok && upper == 0 {
+		return 0, 0, true
+	}
+	if lower > 0 {
+		return math.MaxInt, 0, false
+	}
+
+	lower, _, _ = SizeHint(c.iter)
+	return lower, 0, false
+}
+
+// Stop stops the current pass and the original iterator, if they are
+// Stoppers.
+func (c *Cycled[T]) Stop() {
+	stop(c.iter)
+	stop(c.orig)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (c *Cycled[T]) Clone() Iterable[T] {
+	it := clone(c.iter)
+	return &Cycled[T]{c.orig, it, valueFunc(it)}
+}
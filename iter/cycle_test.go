@@ -0,0 +1,141 @@
+package iter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleCycle() {
+	backends := iter.Cycle[string](iter.New([]string{"a", "b", "c"}))
+
+	fmt.Println(backends.Take(7).Collect())
+	// Output:
+	// [a b c a b c a]
+}
+
+func ExampleCycle_empty() {
+	i := iter.Cycle[int](iter.New([]int{}))
+
+	fmt.Println(i.Next())
+	// Output:
+	// <nil>
+}
+
+func ExampleCloner() {
+	i := iter.New([]int{1, 2, 3, 4})
+	i.Next()
+
+	c := i.Clone()
+
+	fmt.Println(i.Collect())
+	fmt.Println(iter.Collect(c))
+	// Output:
+	// [2 3 4]
+	// [2 3 4]
+}
+
+func TestClone(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5, 6}
+	isOdd := func(i int) bool { return i%2 != 0 }
+	double := func(i int) int { return i * 2 }
+	toIter := func(s []int) iter.Iterable[int] { return iter.New(s) }
+	nested := iter.Map[[]int](iter.New([][]int{nums[:3], nums[3:]}), toIter)
+
+	cases := []struct {
+		name string
+		it   iter.Cloner[int]
+	}{
+		{"Iterator", iter.New(nums)},
+		{"RevIterator", iter.New(nums).Rev()},
+		{"Reversed", iter.Rev[int](iter.New(nums))},
+		{"Mapped", iter.Map[int, int](iter.New(nums), double)},
+		{"Filtered", iter.Filter[int](iter.New(nums), isOdd)},
+		{"Chained", iter.Chain[int](iter.New(nums[:1]), iter.New(nums[3:]))},
+		{"Skipped", iter.Skip[int](iter.New(nums), 1)},
+		{"Taken", iter.Take[int](iter.New(nums), 4)},
+		{"Stepped", iter.StepBy[int](iter.New(nums), 2)},
+		{"SkipWhileT", iter.SkipWhile[int](iter.New(nums), isOdd)},
+		{"TakeWhileT", iter.TakeWhile[int](iter.New([]int{1, 3, 5, 6}), isOdd)},
+		{"Fused", iter.Fuse[int](iter.New(nums))},
+		{"PeekableT", iter.Peekable[int](iter.New(nums))},
+		{"Flat", iter.Flatten[int](nested)},
+		{"Cycled", iter.Cycle[int](iter.New(nums[:2])).Take(5)},
+	}
+
+	for _, c := range cases {
+		c.it.Next()
+		if p, ok := c.it.(*iter.PeekableT[int]); ok {
+			p.Peek()
+		}
+
+		clone := c.it.Clone()
+		want := fmt.Sprint(iter.Collect[int](c.it))
+		have := fmt.Sprint(iter.Collect(clone))
+
+		if have != want {
+			t.Errorf("%v Clone \n\thave %v\n\twant %v", c.name, have, want)
+		}
+	}
+}
+
+func TestClone_panic(t *testing.T) {
+	m := iter.Map[int, int](iter.FromSeq(func(func(int) bool) {}), func(i int) int { return i })
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	m.Clone()
+}
+
+func TestCycle_panic(t *testing.T) {
+	m := iter.Map[int, int](iter.FromSeq(func(func(int) bool) {}), func(i int) int { return i })
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	iter.Cycle[int](m)
+}
+
+func ExampleIsCloneable() {
+	double := func(i int) int { return i * 2 }
+	nums := iter.Map[int, int](iter.New([]int{1, 2, 3}), double)
+	seq := iter.Map[int, int](iter.FromSeq(func(yield func(int) bool) {}), double)
+
+	fmt.Println(iter.IsCloneable[int](nums))
+	fmt.Println(iter.IsCloneable[int](seq))
+	// Output:
+	// true
+	// false
+}
+
+func TestIsCloneable(t *testing.T) {
+	all := func(i int) bool { return true }
+	seq := iter.FromSeq(func(yield func(int) bool) {})
+
+	cases := []struct {
+		name string
+		it   iter.Iterable[int]
+		want bool
+	}{
+		{"Iterator", iter.New([]int{1, 2}), true},
+		{"FromSeq", seq, false},
+		{"Filtered", iter.Filter[int](iter.New([]int{1, 2}), all), true},
+		{"Filtered unknown", iter.Filter[int](seq, all), false},
+		{"Chained", iter.Chain[int](iter.New([]int{1}), iter.New([]int{2})), true},
+		{"Chained unknown", iter.Chain[int](iter.New([]int{1}), seq), false},
+		{"Fused", iter.Fuse[int](iter.Peekable[int](iter.New([]int{1}))), true},
+		{"Fused unknown", iter.Fuse[int](iter.Peekable[int](seq)), false},
+	}
+
+	for _, c := range cases {
+		if have := iter.IsCloneable(c.it); have != c.want {
+			t.Errorf("%v IsCloneable \n\thave %v\n\twant %v", c.name, have, c.want)
+		}
+	}
+}
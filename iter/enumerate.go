@@ -0,0 +1,104 @@
+package iter
+
+// Enumerated is an Iterable that yields the current count and the element
+// during iteration.
+type Enumerated[T any] struct {
+	iter  Iterable[T]
+	pull  func() (T, bool)
+	count int
+}
+
+// Enumerate creates an iterator which gives the current iteration count as
+// well as the next value.
+//
+// The iterator returned yields pairs (i, val), where i is the current index of
+// iteration and val is the value returned by the iterator.
+func Enumerate[T any](iter Iterable[T]) *Enumerated[T] {
+	return &Enumerated[T]{iter, valueFunc(iter), 0}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (e *Enumerated[T]) Next() *Pair[int, T] {
+	next := e.iter.Next()
+	if next == nil {
+		return nil
+	}
+
+	result := Pair[int, T]{e.count, *next}
+	e.count += 1
+
+	return &result
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (e *Enumerated[T]) NextValue() (Pair[int, T], bool) {
+	next, ok := e.pull()
+	if !ok {
+		return Pair[int, T]{}, false
+	}
+
+	result := Pair[int, T]{e.count, next}
+	e.count += 1
+
+	return result, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (e *Enumerated[T]) NextBack() *Pair[int, T] {
+	next := back(e.iter).NextBack()
+	if next == nil {
+		return nil
+	}
+
+	result := Pair[int, T]{e.count + lenOf(e.iter), *next}
+
+	return &result
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended and its length is known exactly.
+func (e *Enumerated[T]) DoubleEnded() bool {
+	_, ok := exactLen(e.iter)
+	return ok && IsDoubleEnded(e.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (e *Enumerated[T]) SizeHint() (int, int, bool) {
+	return SizeHint(e.iter)
+}
+
+func (e *Enumerated[T]) drain(n int) (int, bool) {
+	count, ok := drain(e.iter, n)
+	e.count += count
+
+	return count, ok
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (e *Enumerated[T]) Stop() {
+	stop(e.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (e *Enumerated[T]) Clone() Iterable[Pair[int, T]] {
+	c := Enumerate(clone(e.iter))
+	c.count = e.count
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (e *Enumerated[T]) Cloneable() bool {
+	return IsCloneable(e.iter)
+}
@@ -0,0 +1,37 @@
+package iter_test
+
+import (
+	"fmt"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleEnumerate() {
+	i := iter.Enumerate[string](iter.New([]string{"a", "b", "c"}))
+
+	for val := i.Next(); val != nil; val = i.Next() {
+		fmt.Println(val.First, val.Second)
+	}
+	// Output:
+	// 0 a
+	// 1 b
+	// 2 c
+}
+
+func ExampleEnumerate_rev() {
+	i := iter.Enumerate[string](iter.New([]string{"a", "b", "c"}))
+
+	fmt.Println(iter.Rev[iter.Pair[int, string]](i).Collect())
+	// Output:
+	// [{2 c} {1 b} {0 a}]
+}
+
+func ExampleEnumerated_Filter() {
+	isOdd := func(p iter.Pair[int, string]) bool { return p.First%2 != 0 }
+	i := iter.Enumerate[string](iter.New([]string{"a", "b", "c", "d"})).
+		Filter(isOdd)
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [{1 b} {3 d}]
+}
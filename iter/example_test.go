@@ -307,3 +307,14 @@ func ExampleMax() {
 	// <nil>
 	// bcd
 }
+
+func ExampleSizeHint() {
+	isEven := func(n int) bool { return n%2 == 0 }
+	i := iter.New([]int{1, 2, 3, 4, 5})
+
+	fmt.Println(iter.SizeHint[int](i.Take(3)))
+	fmt.Println(iter.SizeHint[int](i.Filter(isEven)))
+	// Output:
+	// 3 3 true
+	// 0 5 true
+}
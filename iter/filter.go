@@ -2,6 +2,7 @@ package iter
 
 type Filtered[T any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	pred func(T) bool
 }
 
@@ -11,14 +12,68 @@ type Filtered[T any] struct {
 // The returned iterator will yield only the elements for which the predicate
 // returns true.
 func Filter[T any](iter Iterable[T], pred func(T) bool) *Filtered[T] {
-	return &Filtered[T]{iter, pred}
+	return &Filtered[T]{iter, valueFunc(iter), pred}
 }
 
 // Next advances the iterator and returns the next value.
 //
 // Returns nil when iteration is finished.
 func (f *Filtered[T]) Next() *T {
-	return f.iter.Find(f.pred)
+	return find(f.iter, f.pred)
 }
 
-//go:generate go run ./cmd/gen/ -name Filtered -output filter_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (f *Filtered[T]) NextValue() (T, bool) {
+	for next, ok := f.pull(); ok; next, ok = f.pull() {
+		if f.pred(next) {
+			return next, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (f *Filtered[T]) NextBack() *T {
+	return RFind(back(f.iter), f.pred)
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended.
+func (f *Filtered[T]) DoubleEnded() bool {
+	return IsDoubleEnded(f.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The lower bound is always 0, as the predicate may reject every element.
+func (f *Filtered[T]) SizeHint() (int, int, bool) {
+	_, upper, ok := SizeHint(f.iter)
+	return 0, upper, ok
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (f *Filtered[T]) Stop() {
+	stop(f.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The predicate is shared between the clones.
+// Panics if Cloneable reports false.
+func (f *Filtered[T]) Clone() Iterable[T] {
+	return Filter(clone(f.iter), f.pred)
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (f *Filtered[T]) Cloneable() bool {
+	return IsCloneable(f.iter)
+}
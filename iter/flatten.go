@@ -1,14 +1,24 @@
 package iter
 
 // Flat is an Iterable that flattens one level of nesting in an Iterable of Iteraables
+//
+// The outer iterator is dropped once exhausted, and never polled again.
 type Flat[I any] struct {
-	outer Iterable[Iterable[I]]
-	inner *Iterable[I]
+	outer     Iterable[Iterable[I]]
+	pullOuter func() (Iterable[I], bool)
+	inner     Iterable[I]
+	pullInner func() (I, bool)
 }
 
 // Flatten creates an iterator that flattens nested structure.
 func Flatten[I any](it Iterable[Iterable[I]]) *Flat[I] {
-	return &Flat[I]{it, it.Next()}
+	return &Flat[I]{outer: it, pullOuter: valueFunc(it)}
+}
+
+// setInner makes inner the current inner iterator.
+func (f *Flat[T]) setInner(inner Iterable[T]) {
+	f.inner = inner
+	f.pullInner = valueFunc(inner)
 }
 
 // Next advances the iterator and returns the next value.
@@ -16,18 +26,90 @@ func Flatten[I any](it Iterable[Iterable[I]]) *Flat[I] {
 // Returns nil when iteration is finished.
 func (f *Flat[T]) Next() *T {
 	for {
-		if f.inner == nil {
+		if f.inner != nil {
+			if next := f.inner.Next(); next != nil {
+				return next
+			}
+			f.inner = nil
+		}
+
+		if f.outer == nil {
+			return nil
+		}
+
+		inner := f.outer.Next()
+		if inner == nil {
+			f.outer = nil
 			return nil
 		}
+		f.setInner(*inner)
+	}
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (f *Flat[T]) NextValue() (T, bool) {
+	for {
+		if f.inner != nil {
+			if next, ok := f.pullInner(); ok {
+				return next, true
+			}
+			f.inner = nil
+		}
 
-		next := (*f.inner).Next()
-		if next == nil {
-			f.inner = f.outer.Next()
-			continue
+		var zero T
+		if f.outer == nil {
+			return zero, false
 		}
 
-		return next
+		inner, ok := f.pullOuter()
+		if !ok {
+			f.outer = nil
+			return zero, false
+		}
+		f.setInner(inner)
+	}
+}
+
+// Stop stops the current inner iterator and the outer iterator, if they are
+// Stoppers. Inner iterators not yet reached are not stopped.
+func (f *Flat[T]) Stop() {
+	if f.inner != nil {
+		stop(f.inner)
 	}
+	if f.outer != nil {
+		stop(f.outer)
+	}
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Inner iterators not yet reached are not cloned; if the outer iterator yields
+// the same inner iterators to each clone, they are shared, so the outer
+// iterator should produce them afresh, eg with Map. Panics if Cloneable
+// reports false.
+func (f *Flat[T]) Clone() Iterable[T] {
+	c := &Flat[T]{}
+	if f.outer != nil {
+		c.outer = clone(f.outer)
+		c.pullOuter = valueFunc(c.outer)
+	}
+	if f.inner != nil {
+		c.setInner(clone(f.inner))
+	}
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the outer
+// iterator and the current inner iterator are cloneable.
+func (f *Flat[T]) Cloneable() bool {
+	return (f.outer == nil || IsCloneable(f.outer)) &&
+		(f.inner == nil || IsCloneable(f.inner))
 }
 
-//go:generate go run ./cmd/gen/ -name Flat -output flatten_ext_gen.go
+// Fused marks this iterator as a FusedIterable.
+func (f *Flat[T]) Fused() {}
@@ -0,0 +1,21 @@
+package iter
+
+// FnIterator is an Iterable whose elements are produced by a function.
+type FnIterator[T any] struct {
+	fn func() *T
+}
+
+// FromFn creates an iterator that calls fn to produce each element.
+//
+// Iteration is finished when fn returns nil. Any state must be captured by the
+// closure; the iterator is not fused unless fn is.
+func FromFn[T any](fn func() *T) *FnIterator[T] {
+	return &FnIterator[T]{fn}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (f *FnIterator[T]) Next() *T {
+	return f.fn()
+}
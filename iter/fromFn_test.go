@@ -0,0 +1,22 @@
+package iter_test
+
+import (
+	"fmt"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleFromFn() {
+	count := 0
+	counter := func() *int {
+		if count >= 3 {
+			return nil
+		}
+		count += 1
+		return &count
+	}
+
+	fmt.Println(iter.FromFn(counter).Collect())
+	// Output:
+	// [1 2 3]
+}
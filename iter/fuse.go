@@ -0,0 +1,135 @@
+package iter
+
+// FusedIterable is an Iterable that always continues to return nil once it has
+// returned nil.
+//
+// Iterables in general make no such guarantee; once exhausted, a user defined
+// source may yield further elements. Calling Fuse on any Iterable produces a
+// FusedIterable.
+type FusedIterable[T any] interface {
+	Iterable[T]
+	// Fused is a marker method. It has no effect.
+	Fused()
+}
+
+// Fused is an Iterable that ends permanently after the first nil returned by the
+// underlying iterator.
+type Fused[T any] struct {
+	iter Iterable[T]
+	pull func() (T, bool)
+	done bool
+}
+
+// Fuse creates an iterator which ends after the first nil.
+//
+// After an iterator returns nil, future calls may or may not yield further
+// elements. Fuse adapts an iterator, ensuring that after a nil is given, it
+// will always return nil forever, and the underlying iterator is not polled
+// again.
+func Fuse[T any](iter Iterable[T]) *Fused[T] {
+	return &Fused[T]{iter, valueFunc(iter), false}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (f *Fused[T]) Next() *T {
+	if f.done {
+		return nil
+	}
+
+	next := f.iter.Next()
+	if next == nil {
+		f.done = true
+	}
+
+	return next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (f *Fused[T]) NextValue() (T, bool) {
+	var next T
+	if f.done {
+		return next, false
+	}
+
+	next, ok := f.pull()
+	if !ok {
+		f.done = true
+	}
+
+	return next, ok
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (f *Fused[T]) NextBack() *T {
+	if f.done {
+		return nil
+	}
+
+	next := back(f.iter).NextBack()
+	if next == nil {
+		f.done = true
+	}
+
+	return next
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended.
+func (f *Fused[T]) DoubleEnded() bool {
+	return IsDoubleEnded(f.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (f *Fused[T]) SizeHint() (int, int, bool) {
+	if f.done {
+		return 0, 0, true
+	}
+
+	return SizeHint(f.iter)
+}
+
+func (f *Fused[T]) drain(n int) (int, bool) {
+	if f.done {
+		return 0, true
+	}
+
+	count, ok := drain(f.iter, n)
+	if ok && count < n {
+		f.done = true
+	}
+
+	return count, ok
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (f *Fused[T]) Stop() {
+	stop(f.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (f *Fused[T]) Clone() Iterable[T] {
+	if f.done {
+		return &Fused[T]{f.iter, f.pull, true}
+	}
+
+	return Fuse(clone(f.iter))
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable or has been exhausted.
+func (f *Fused[T]) Cloneable() bool {
+	return f.done || IsCloneable(f.iter)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (f *Fused[T]) Fused() {}
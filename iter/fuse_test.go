@@ -0,0 +1,83 @@
+package iter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+// flaky yields 0, 1, ... but returns nil on every third call, resuming
+// afterwards. It counts how often it is polled.
+type flaky struct {
+	calls, n int
+}
+
+func (f *flaky) Next() *int {
+	f.calls += 1
+	if f.calls%3 == 0 {
+		return nil
+	}
+
+	n := f.n
+	f.n += 1
+
+	return &n
+}
+
+func (f *flaky) Find(pred func(int) bool) *int {
+	return iter.Find[int](f, pred)
+}
+
+func ExampleFuse() {
+	f := &flaky{}
+
+	fmt.Println(*f.Next(), *f.Next(), f.Next(), *f.Next())
+
+	fused := iter.Fuse[int](&flaky{})
+	fmt.Println(*fused.Next(), *fused.Next(), fused.Next(), fused.Next())
+	// Output:
+	// 0 1 <nil> 2
+	// 0 1 <nil> <nil>
+}
+
+func TestFused(t *testing.T) {
+	isAny := func(int) bool { return true }
+	flat := func(f *flaky) iter.Iterable[int] {
+		return iter.Flatten[int](iter.New([]iter.Iterable[int]{f}))
+	}
+
+	cases := []struct {
+		name  string
+		build func(*flaky) iter.Iterable[int]
+	}{
+		{"Fused", func(f *flaky) iter.Iterable[int] { return iter.Fuse[int](f) }},
+		{"Chained", func(f *flaky) iter.Iterable[int] { return iter.Chain[int](f, iter.New([]int{})) }},
+		{"Flat", flat},
+		{"Stepped", func(f *flaky) iter.Iterable[int] { return iter.StepBy[int](f, 1) }},
+		{"SkipWhileT", func(f *flaky) iter.Iterable[int] { return iter.SkipWhile[int](f, isAny) }},
+		{"TakeWhileT", func(f *flaky) iter.Iterable[int] { return iter.TakeWhile[int](f, isAny) }},
+		{"Taken", func(f *flaky) iter.Iterable[int] { return iter.Take[int](f, 5) }},
+	}
+
+	for _, c := range cases {
+		f := &flaky{}
+		it := c.build(f)
+
+		for it.Next() != nil {
+		}
+		polled := f.calls
+
+		for i := 0; i < 3; i++ {
+			if have := it.Next(); have != nil {
+				t.Errorf("%v resurrected element %v", c.name, *have)
+			}
+		}
+		if f.calls != polled {
+			t.Errorf("%v polled an exhausted input %v times", c.name, f.calls-polled)
+		}
+		if _, ok := it.(iter.FusedIterable[int]); !ok {
+			t.Errorf("%v is not a FusedIterable", c.name)
+		}
+	}
+}
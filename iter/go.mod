@@ -1,5 +1,5 @@
 module github.com/partylich/go/iter
 
-go 1.18
+go 1.23
 
 require golang.org/x/exp v0.0.0-20220518171630-0b5c67f07fdf
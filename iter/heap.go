@@ -0,0 +1,79 @@
+package iter
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// HeapIterator is an iterator that pops the elements of a container/heap in
+// priority order.
+type HeapIterator[T any] struct {
+	h heap.Interface
+}
+
+// DrainHeap creates an iterator that pops elements from h, yielding them in the
+// order defined by its Less method.
+//
+// Iteration consumes the heap: each element yielded has been removed from it.
+// Elements not yet yielded remain in the heap, which may be modified between
+// calls to Next.
+func DrainHeap[T any](h heap.Interface) *HeapIterator[T] {
+	return &HeapIterator[T]{h}
+}
+
+// HeapSorted creates an iterator over the elements of a container/heap in
+// priority order, without modifying it.
+//
+// The heap is copied when HeapSorted is called, and the copy drained as for
+// DrainHeap.
+func HeapSorted[S ~[]T, PS interface {
+	*S
+	heap.Interface
+}, T any](h S) *HeapIterator[T] {
+	c := slices.Clone(h)
+	return DrainHeap[T](PS(&c))
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if the next value does not conform
+// to the specified type. Such a value is left in the heap.
+func (it *HeapIterator[T]) Next() *T {
+	next, ok := it.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished, or if the next
+// value does not conform to the specified type. Such a value is left in the
+// heap.
+func (it *HeapIterator[T]) NextValue() (T, bool) {
+	var zero T
+	if it.h.Len() == 0 {
+		return zero, false
+	}
+
+	x := heap.Pop(it.h)
+	el, ok := x.(T)
+	if !ok {
+		// the heap has no way to inspect its top element without popping it
+		heap.Push(it.h, x)
+		return zero, false
+	}
+
+	return el, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The lower bound is 0, as iteration finishes early at an element that does not
+// conform to the specified type.
+func (it *HeapIterator[T]) SizeHint() (int, int, bool) {
+	return 0, it.h.Len(), true
+}
@@ -0,0 +1,90 @@
+package iter_test
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+// intHeap is a min-heap of ints.
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *intHeap) Push(x any) {
+	*h = append(*h, x.(int))
+}
+
+func (h *intHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+
+	return x
+}
+
+func ExampleDrainHeap() {
+	h := &intHeap{5, 2, 8, 1, 9}
+	heap.Init(h)
+
+	fmt.Println(iter.DrainHeap[int](h).Take(3).Collect())
+	fmt.Println(h.Len())
+	// Output:
+	// [1 2 5]
+	// 2
+}
+
+func ExampleHeapSorted() {
+	h := &intHeap{5, 2, 8, 1, 9}
+	heap.Init(h)
+
+	fmt.Println(iter.HeapSorted(*h).Collect())
+	fmt.Println(h.Len())
+	// Output:
+	// [1 2 5 8 9]
+	// 5
+}
+
+func TestDrainHeap(t *testing.T) {
+	h := &intHeap{3, 1, 2}
+	heap.Init(h)
+	i := iter.DrainHeap[int](h)
+
+	if lower, upper, ok := i.SizeHint(); lower != 0 || upper != 3 || !ok {
+		t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, 0, 3, true)
+	}
+
+	if have := *i.Next(); have != 1 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 1)
+	}
+
+	// the heap may be modified during iteration
+	heap.Push(h, 0)
+	if have, want := i.Collect(), []int{0, 2, 3}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+	if n := h.Len(); n != 0 {
+		t.Errorf("heap Len \n\thave %v\n\twant %v", n, 0)
+	}
+}
+
+func TestDrainHeap_type(t *testing.T) {
+	h := &intHeap{3, 1, 2}
+	heap.Init(h)
+
+	// the elements are ints, so none conform
+	if n := iter.DrainHeap[int8](h).Count(); n != 0 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 0)
+	}
+
+	// the non-conforming element is left in the heap
+	if have, want := iter.DrainHeap[int](h).Collect(), []int{1, 2, 3}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
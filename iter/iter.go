@@ -1,7 +1,12 @@
 // Package iter provides generic iterators and iterator adapters, with an intentionally Rust-y flavor
 package iter
 
-import is "golang.org/x/exp/constraints"
+import (
+	"math"
+	"slices"
+
+	is "golang.org/x/exp/constraints"
+)
 
 type Iterable[T any] interface {
 	// Next advances the iterator and returns the next value.
@@ -16,10 +21,180 @@ type Iterable[T any] interface {
 	// nil.
 	//
 	// Find is short-circuiting; in other words, it will stop processing as soon as
-	// the predicate returns true.
+	// the predicate returns true. The iterator is then stopped if it is a
+	// Stopper, as the rest of its elements are abandoned.
 	Find(pred func(T) bool) *T
 }
 
+// DoubleEndedIterable is an Iterable able to yield elements from both ends.
+//
+// Elements are yielded exactly once; iteration is finished when the front and
+// back meet, regardless of which end they were taken from.
+//
+// Adapters such as Map declare NextBack whatever the iterators they adapt, but
+// are only able to yield elements from the back when those iterators are. They
+// report whether they are with a DoubleEnded method; use IsDoubleEnded to check
+// any iterator.
+type DoubleEndedIterable[T any] interface {
+	Iterable[T]
+	// NextBack removes and returns an element from the end of the iterator.
+	//
+	// Returns nil when iteration is finished.
+	NextBack() *T
+}
+
+// IsDoubleEnded reports whether iter is able to yield elements from the back.
+//
+// An iterator is double ended if it is a DoubleEndedIterable and, if it has a
+// DoubleEnded method, that method reports true.
+func IsDoubleEnded[T any](iter Iterable[T]) bool {
+	if _, ok := iter.(DoubleEndedIterable[T]); !ok {
+		return false
+	}
+	if d, ok := iter.(interface{ DoubleEnded() bool }); ok {
+		return d.DoubleEnded()
+	}
+
+	return true
+}
+
+// checkBack panics if iter is not double ended, naming the function requiring
+// it.
+func checkBack[T any](iter Iterable[T], name string) {
+	if !IsDoubleEnded(iter) {
+		panic(name + " requires a double ended iterator")
+	}
+}
+
+// ValueIterable is an Iterable able to yield its elements by value.
+//
+// Returning a pointer from Next often requires the element to be allocated on
+// the heap. NextValue avoids that allocation, and is used by the consumers in
+// this package whenever it is available.
+type ValueIterable[T any] interface {
+	Iterable[T]
+	// NextValue advances the iterator and returns the next value, and whether
+	// there was one.
+	//
+	// Returns the zero value and false when iteration is finished.
+	NextValue() (T, bool)
+}
+
+// valueFunc returns a function advancing iter and returning the next value, and
+// whether there was one, using NextValue when it is available.
+//
+// iter is checked for NextValue once, rather than for each element, so
+// adapters call valueFunc when they are created, and consumers before they
+// start iterating.
+func valueFunc[T any](iter Iterable[T]) func() (T, bool) {
+	if v, ok := iter.(ValueIterable[T]); ok {
+		return v.NextValue
+	}
+
+	return func() (T, bool) {
+		next := iter.Next()
+		if next == nil {
+			var zero T
+			return zero, false
+		}
+
+		return *next, true
+	}
+}
+
+// SizeHinter is implemented by iterators able to bound their remaining length.
+type SizeHinter interface {
+	// SizeHint returns the bounds on the remaining length of the iterator.
+	//
+	// lower is the minimum number of elements remaining. upper is the maximum,
+	// and is only meaningful when ok is true. ok is false when the upper bound is
+	// unknown or does not fit in an int.
+	SizeHint() (lower int, upper int, ok bool)
+}
+
+// ExactSize is implemented by iterators that always know exactly how many
+// elements remain.
+//
+// Adapters do not implement ExactSize, as their length is only known when that
+// of the iterators they adapt is; their SizeHint reports an exact length when
+// it is known.
+type ExactSize interface {
+	SizeHinter
+	// Len returns the exact number of elements remaining in the iterator.
+	//
+	// Len panics if the number is too large to be counted by an int.
+	Len() int
+}
+
+// SizeHint returns the bounds on the remaining length of an iterator.
+//
+// Iterators which are not SizeHinters report a lower bound of 0 and no upper
+// bound.
+func SizeHint[T any](iter Iterable[T]) (lower int, upper int, ok bool) {
+	if s, isHinter := iter.(SizeHinter); isHinter {
+		return s.SizeHint()
+	}
+
+	return 0, 0, false
+}
+
+// back asserts that iter is double ended, panicking if it is not.
+func back[T any](iter Iterable[T]) DoubleEndedIterable[T] {
+	b, ok := iter.(DoubleEndedIterable[T])
+	if !ok {
+		panic("NextBack requires a DoubleEndedIterable")
+	}
+
+	return b
+}
+
+// exactLen returns the number of elements remaining in iter, and whether that
+// number is known exactly.
+func exactLen[T any](iter Iterable[T]) (int, bool) {
+	lower, upper, ok := SizeHint(iter)
+	return lower, ok && lower == upper
+}
+
+// lenOf returns the exact number of elements remaining in iter, panicking if
+// it is not known.
+func lenOf[T any](iter Iterable[T]) int {
+	n, ok := exactLen(iter)
+	if !ok {
+		panic("iterator length is not known exactly")
+	}
+
+	return n
+}
+
+// addHint sums two size hints, saturating the lower bound and discarding the
+// upper bound on overflow.
+func addHint(loA, hiA int, okA bool, loB, hiB int, okB bool) (int, int, bool) {
+	lower := loA + loB
+	if lower < loA {
+		lower = math.MaxInt
+	}
+
+	upper := hiA + hiB
+	if !okA || !okB || upper < hiA {
+		return lower, 0, false
+	}
+
+	return lower, upper, true
+}
+
+// Pair is a generic 2-tuple.
+type Pair[T any, U any] struct {
+	First  T
+	Second U
+}
+
+// Triple is a generic 3-tuple.
+type Triple[T any, U any, V any] struct {
+	First  T
+	Second U
+	Third  V
+}
+
 // Find searches for an element of an iterator that satisfies a predicate.
 //
 // Takes a function that returns true or false. It applies this function to
@@ -28,8 +203,20 @@ type Iterable[T any] interface {
 // nil.
 //
 // Find is short-circuiting; in other words, it will stop processing as soon as
-// the predicate returns true.
+// the predicate returns true. The iterator is then stopped if it is a Stopper,
+// as the rest of its elements are abandoned.
 func Find[T any](iter Iterable[T], pred func(T) bool) *T {
+	next := find(iter, pred)
+	if next != nil {
+		stop(iter)
+	}
+
+	return next
+}
+
+// find is Find without stopping the iterator, for adapters which search their
+// input repeatedly.
+func find[T any](iter Iterable[T], pred func(T) bool) *T {
 	for next := iter.Next(); next != nil; next = iter.Next() {
 		if pred(*next) {
 			return next
@@ -39,13 +226,80 @@ func Find[T any](iter Iterable[T], pred func(T) bool) *T {
 	return nil
 }
 
+// RFind searches for an element of an iterator from the back that satisfies a
+// predicate.
+//
+// RFind is the reverse version of Find. It is short-circuiting, and returns
+// nil if no element satisfies the predicate.
+//
+// Panics if the iterator is not double ended; see IsDoubleEnded.
+func RFind[T any](iter DoubleEndedIterable[T], pred func(T) bool) *T {
+	checkBack[T](iter, "RFind")
+
+	for next := iter.NextBack(); next != nil; next = iter.NextBack() {
+		if pred(*next) {
+			return next
+		}
+	}
+
+	return nil
+}
+
+// Position searches for an element in an iterator, returning its index.
+//
+// Position is short-circuiting; it will stop processing as soon as the
+// predicate returns true, and stop the iterator if it is a Stopper. It returns
+// -1 if no element satisfies the predicate.
+func Position[T any](iter Iterable[T], pred func(T) bool) int {
+	idx := 0
+
+	for next := iter.Next(); next != nil; next = iter.Next() {
+		if pred(*next) {
+			stop(iter)
+			return idx
+		}
+		idx += 1
+	}
+
+	return -1
+}
+
+// RPosition searches for an element in an iterator from the back, returning
+// its index counted from the front.
+//
+// RPosition is short-circuiting; it will stop processing as soon as the
+// predicate returns true. It returns -1 if no element satisfies the predicate.
+//
+// If the iterator does not know its remaining length, the elements preceding
+// the match are consumed in order to count them.
+//
+// Panics if the iterator is not double ended; see IsDoubleEnded.
+func RPosition[T any](iter DoubleEndedIterable[T], pred func(T) bool) int {
+	checkBack[T](iter, "RPosition")
+
+	for next := iter.NextBack(); next != nil; next = iter.NextBack() {
+		if !pred(*next) {
+			continue
+		}
+
+		if n, ok := exactLen[T](iter); ok {
+			return n
+		}
+
+		return Count[T](iter)
+	}
+
+	return -1
+}
+
 // Reduce repeatedly applies a reducing operation, reducing the iterator to a
 // single element
 func Reduce[T any, O any](iter Iterable[T], init O, fn func(O, T) O) O {
+	pull := valueFunc(iter)
 	accum := init
 
-	for val := iter.Next(); val != nil; val = iter.Next() {
-		accum = fn(accum, *val)
+	for val, ok := pull(); ok; val, ok = pull() {
+		accum = fn(accum, val)
 	}
 
 	return accum
@@ -59,23 +313,76 @@ func Fold[T any, O any](iter Iterable[T], init O, fn func(O, T) O) O {
 	return Reduce(iter, init, fn)
 }
 
+// RFold repeatedly applies a reducing operation from the back, reducing the
+// iterator to a single element.
+//
+// RFold is the reverse version of Fold.
+//
+// Panics if the iterator is not double ended; see IsDoubleEnded.
+func RFold[T any, O any](iter DoubleEndedIterable[T], init O, fn func(O, T) O) O {
+	checkBack[T](iter, "RFold")
+
+	accum := init
+
+	for val := iter.NextBack(); val != nil; val = iter.NextBack() {
+		accum = fn(accum, *val)
+	}
+
+	return accum
+}
+
 // Collect transforms an iterator into a slice.
+//
+// The slice is preallocated using the iterator's size hint, when available.
 func Collect[T any](iter Iterable[T]) []T {
 	var out []T
+	if lower, _, _ := SizeHint(iter); lower > 0 {
+		out = make([]T, 0, lower)
+	}
 
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		out = append(out, *next)
+	pull := valueFunc(iter)
+	for next, ok := pull(); ok; next, ok = pull() {
+		out = append(out, next)
 	}
 
 	return out
 }
 
+// drainer is implemented by iterators able to discard elements without
+// visiting them, and without observable side effects.
+type drainer interface {
+	// drain discards up to n elements, returning the number discarded and
+	// whether the iterator was able to; if not, nothing is discarded. drain(0)
+	// reports whether the iterator is able to, without discarding anything.
+	drain(n int) (int, bool)
+}
+
+// drain discards up to n elements of iter without visiting them, returning the
+// number discarded, if iter is able to.
+func drain[T any](iter Iterable[T], n int) (int, bool) {
+	if d, ok := iter.(drainer); ok {
+		return d.drain(n)
+	}
+
+	return 0, false
+}
+
 // Count consumes the iterator, counting the number of iterations and returning
 // it.
+//
+// Iterators of known length over slices and ranges, and the Take, Skip, StepBy
+// and Chain adapters over them, are counted in constant time.
 func Count[T any](iter Iterable[T]) int {
+	if n, ok := exactLen(iter); ok {
+		if count, ok := drain(iter, n); ok {
+			return count
+		}
+	}
+
 	count := 0
+	pull := valueFunc(iter)
 
-	for next := iter.Next(); next != nil; next = iter.Next() {
+	for _, ok := pull(); ok; _, ok = pull() {
 		count += 1
 	}
 
@@ -87,8 +394,10 @@ func Count[T any](iter Iterable[T]) int {
 // This is equivalent to using a for loop on the iterator, although break and
 // continue are not possible.
 func ForEach[T any](iter Iterable[T], fn func(T)) {
-	for val := iter.Next(); val != nil; val = iter.Next() {
-		fn(*val)
+	pull := valueFunc(iter)
+
+	for val, ok := pull(); ok; val, ok = pull() {
+		fn(val)
 	}
 }
 
@@ -126,20 +435,56 @@ func Nth[T any](iter Iterable[T], n int) *T {
 // The first slice contains all of the elements for which the predicate returned
 // true, and the second slice contains all of the elements for which it returned
 // false.
+//
+// When the length of the iterator is known exactly, both slices share a single
+// preallocated backing array.
 func Partition[T any](iter Iterable[T], pred func(T) bool) ([]T, []T) {
 	var a, b []T
+	pull := valueFunc(iter)
 
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		if pred(*next) {
-			a = append(a, *next)
+	if n, ok := exactLen(iter); ok && n > 0 {
+		a, b = partitionExact(pull, pred, n)
+	}
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		if pred(next) {
+			a = append(a, next)
 		} else {
-			b = append(b, *next)
+			b = append(b, next)
 		}
 	}
 
 	return a, b
 }
 
+// partitionExact partitions the next n elements pulled into a single buffer,
+// filling matches from the front and the rest from the back.
+func partitionExact[T any](pull func() (T, bool), pred func(T) bool, n int) ([]T, []T) {
+	buf := make([]T, n)
+	i, j := 0, n
+
+	for i < j {
+		next, ok := pull()
+		if !ok {
+			break
+		}
+
+		if pred(next) {
+			buf[i] = next
+			i += 1
+		} else {
+			j -= 1
+			buf[j] = next
+		}
+	}
+
+	b := buf[j:]
+	slices.Reverse(b)
+
+	// cap a so that appending to it may not overwrite b
+	return buf[:i:i], b
+}
+
 type Chainer[T any] interface {
 	Chain(Iterable[T]) Iterable[T]
 }
@@ -152,12 +497,15 @@ type Chainer[T any] interface {
 //
 // All is short-circuiting; in other words, it will stop processing as soon as
 // it finds a false, given that no matter what else happens, the result will
-// also be false.
+// also be false. The iterator is then stopped if it is a Stopper.
 //
 // An empty iterator returns true.
 func All[T any](iter Iterable[T], pred func(T) bool) bool {
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		if !pred(*next) {
+	pull := valueFunc(iter)
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		if !pred(next) {
+			stop(iter)
 			return false
 		}
 	}
@@ -173,12 +521,15 @@ func All[T any](iter Iterable[T], pred func(T) bool) bool {
 //
 // Any is short-circuiting; in other words, it will stop processing as soon as
 // it finds a true, given that no matter what else happens, the result will also
-// be true.
+// be true. The iterator is then stopped if it is a Stopper.
 //
 // An empty iterator returns false.
 func Any[T any](iter Iterable[T], pred func(T) bool) bool {
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		if pred(*next) {
+	pull := valueFunc(iter)
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		if pred(next) {
+			stop(iter)
 			return true
 		}
 	}
@@ -188,26 +539,38 @@ func Any[T any](iter Iterable[T], pred func(T) bool) bool {
 
 // Min returns the minimum element of an iterator.
 func Min[T is.Ordered](iter Iterable[T]) *T {
-	var min *T
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		if min == nil || *min > *next {
+	pull := valueFunc(iter)
+
+	min, found := pull()
+	if !found {
+		return nil
+	}
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		if min > next {
 			min = next
 		}
 	}
 
-	return min
+	return &min
 }
 
 // Max returns the maximum element of an iterator.
 func Max[T is.Ordered](iter Iterable[T]) *T {
-	var max *T
-	for next := iter.Next(); next != nil; next = iter.Next() {
-		if max == nil || *max < *next {
+	pull := valueFunc(iter)
+
+	max, found := pull()
+	if !found {
+		return nil
+	}
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		if max < next {
 			max = next
 		}
 	}
 
-	return max
+	return &max
 }
 
 // Last Consumes the iterator, returning the last element.
@@ -216,6 +579,18 @@ func Max[T is.Ordered](iter Iterable[T]) *T {
 // it keeps track of the current element. After nil is returned, Last will then
 // return the last element it saw.
 func Last[T any](iter Iterable[T]) *T {
-	last := func(_ *T, t T) *T { return &t }
-	return Fold(iter, nil, last)
+	pull := valueFunc(iter)
+
+	last, found := pull()
+	if !found {
+		return nil
+	}
+
+	for next, ok := pull(); ok; next, ok = pull() {
+		last = next
+	}
+
+	return &last
 }
+
+//go:generate go run ./cmd/gen/
@@ -2,7 +2,11 @@ package iter
 
 import (
 	"container/list"
+	"context"
+	"math"
+	"slices"
 	"testing"
+	"time"
 )
 
 func assertEq[T comparable](t *testing.T, a, b T) {
@@ -485,6 +489,27 @@ func TestListIterator_Next(t *testing.T) {
 	}
 }
 
+func TestListIterator_modified(t *testing.T) {
+	l := makeList(3)
+	i := FromList[int](l)
+	i.Next()
+
+	// removing elements ends iteration early rather than panicking
+	l.Remove(l.Back())
+	if have, want := Collect[int](i), []int{2}; !slices.Equal(have, want) {
+		t.Errorf("Collect after Remove \n\thave %v\n\twant %v", have, want)
+	}
+
+	// elements pushed during iteration are reached
+	l = makeList(2)
+	i = FromList[int](l)
+	i.Next()
+	l.PushBack(3)
+	if have, want := Collect[int](i), []int{2, 3}; !slices.Equal(have, want) {
+		t.Errorf("Collect after PushBack \n\thave %v\n\twant %v", have, want)
+	}
+}
+
 func TestListIterator_Find(t *testing.T) {
 	pred := func(i int) bool { return i == 2 }
 	l := makeList(3)
@@ -518,3 +543,308 @@ func TestFlat_Find(t *testing.T) {
 	assertEq(t, *f.Find(pred), 2)
 	assertEq(t, f.Find(pred), nil)
 }
+
+func TestAdapterIsDoubleEnded(t *testing.T) {
+	list := []int{1, 2, 3, 4}
+	ident := func(i int) int { return i }
+	all := func(i int) bool { return true }
+
+	var it DoubleEndedIterable[int]
+	it = New(list)
+	it = New(list).Rev()
+	it = FromList[int](makeList(4))
+	it = Rev[int](New(list))
+	it = Filter[int](New(list), all)
+	it = Map[int, int](New(list), ident)
+	it = Chain[int](New(list), New(list))
+	it = Skip[int](New(list), 2)
+	it = Take[int](New(list), 2)
+	_ = it
+}
+
+func TestNextBack(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	isOdd := func(i int) bool { return i%2 != 0 }
+	double := func(i int) int { return i * 2 }
+
+	cases := []struct {
+		name string
+		it   DoubleEndedIterable[int]
+		want []int
+	}{
+		{"Iterator", New(list), []int{5, 4, 3, 2, 1}},
+		{"RevIterator", New(list).Rev(), []int{1, 2, 3, 4, 5}},
+		{"ListIterator", FromList[int](makeList(3)), []int{3, 2, 1}},
+		{"Reversed", Rev[int](New(list)), []int{1, 2, 3, 4, 5}},
+		{"Filtered", Filter[int](New(list), isOdd), []int{5, 3, 1}},
+		{"Mapped", Map[int, int](New(list), double), []int{10, 8, 6, 4, 2}},
+		{"Chained", Chain[int](New(list[:2]), New(list[2:])), []int{5, 4, 3, 2, 1}},
+		{"Skipped", Skip[int](New(list), 3), []int{5, 4}},
+		{"Skipped past end", Skip[int](New(list), 7), []int{}},
+		{"Taken", Take[int](New(list), 2), []int{2, 1}},
+		{"Taken past end", Take[int](New(list), 7), []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, c := range cases {
+		have := RFold[int](c.it, []int{}, func(acc []int, i int) []int {
+			return append(acc, i)
+		})
+
+		if len(have) != len(c.want) {
+			t.Errorf("%v NextBack \n\thave %v\n\twant %v", c.name, have, c.want)
+			continue
+		}
+		for idx, want := range c.want {
+			if have[idx] != want {
+				t.Errorf("%v NextBack \n\thave %v\n\twant %v", c.name, have, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestNextBack_meet(t *testing.T) {
+	cases := []struct {
+		name string
+		it   DoubleEndedIterable[int]
+	}{
+		{"Iterator", New([]int{1, 2, 3})},
+		{"RevIterator", New([]int{3, 2, 1}).Rev()},
+		{"ListIterator", FromList[int](makeList(3))},
+		{"Skipped", Skip[int](New([]int{0, 1, 2, 3}), 1)},
+		{"Taken", Take[int](New([]int{1, 2, 3, 4}), 3)},
+	}
+
+	for _, c := range cases {
+		assertEq(t, *c.it.Next(), 1)
+		assertEq(t, *c.it.NextBack(), 3)
+		assertEq(t, *c.it.NextBack(), 2)
+		if c.it.Next() != nil || c.it.NextBack() != nil {
+			t.Errorf("%v: expected iteration to finish when both ends meet", c.name)
+		}
+	}
+}
+
+func TestIsDoubleEnded(t *testing.T) {
+	all := func(i int) bool { return true }
+	ident := func(i int) int { return i }
+	tw := TakeWhile[int](New([]int{1, 2}), all)
+
+	cases := []struct {
+		name string
+		it   Iterable[int]
+		want bool
+	}{
+		{"Iterator", New([]int{1, 2}), true},
+		{"TakeWhileT", tw, false},
+		{"Mapped", Map[int, int](New([]int{1, 2}), ident), true},
+		{"Mapped unknown", Map[int, int](tw, ident), false},
+		{"Chained", Chain[int](New([]int{1}), New([]int{2})), true},
+		{"Chained unknown", Chain[int](New([]int{1}), tw), false},
+		{"Skipped", Skip[int](New([]int{1, 2}), 1), true},
+		{"Skipped unsized", Skip[int](Filter[int](New([]int{1, 2}), all), 1), false},
+		{"Taken unsized", Take[int](Filter[int](New([]int{1, 2}), all), 1), false},
+		{"Fused", Fuse[int](Peekable[int](New([]int{1, 2}))), true},
+		{"Fused unknown", Fuse[int](Peekable[int](tw)), false},
+	}
+
+	for _, c := range cases {
+		if have := IsDoubleEnded(c.it); have != c.want {
+			t.Errorf("%v IsDoubleEnded \n\thave %v\n\twant %v", c.name, have, c.want)
+		}
+	}
+}
+
+func TestNextBack_panic(t *testing.T) {
+	all := func(i int) bool { return true }
+	tw := TakeWhile[int](New([]int{1, 2}), all)
+	m := Map[int, int](tw, func(i int) int { return i })
+
+	assertPanic(t, func() { m.NextBack() })
+	assertPanic(t, func() { Skip[int](Filter[int](New([]int{1, 2}), all), 1).NextBack() })
+
+	// reverse consumers check before consuming anything
+	assertPanic(t, func() { Rev[int](m) })
+	assertPanic(t, func() { RFind[int](m, all) })
+	assertPanic(t, func() { RPosition[int](m, all) })
+	assertPanic(t, func() { RFold[int](m, 0, func(acc, i int) int { return acc + i }) })
+	assertEq(t, *m.Next(), 1)
+}
+
+func TestEnumerate_NextBack(t *testing.T) {
+	e := Enumerate[string](New([]string{"a", "b", "c", "d"}))
+
+	assertEq(t, *e.Next(), Pair[int, string]{0, "a"})
+	assertEq(t, *e.NextBack(), Pair[int, string]{3, "d"})
+	assertEq(t, *e.NextBack(), Pair[int, string]{2, "c"})
+	assertEq(t, *e.Next(), Pair[int, string]{1, "b"})
+	assertEq(t, e.Next(), nil)
+}
+
+func TestRPosition_unsized(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	f := Filter[int](New([]int{1, 2, 3, 4, 5}), func(int) bool { return true })
+
+	assertEq(t, RPosition[int](f, isEven), 3)
+}
+
+func TestSizeHint(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	ident := func(i int) int { return i }
+	all := func(i int) bool { return true }
+
+	type hint struct {
+		lower, upper int
+		ok           bool
+	}
+	cases := []struct {
+		name string
+		it   Iterable[int]
+		want hint
+	}{
+		{"Iterator", New(list), hint{5, 5, true}},
+		{"RevIterator", New(list).Rev(), hint{5, 5, true}},
+		{"ListIterator", FromList[int](makeList(3)), hint{0, 0, false}},
+		{"Reversed", Rev[int](New(list)), hint{5, 5, true}},
+		{"Mapped", Map[int, int](New(list), ident), hint{5, 5, true}},
+		{"Filtered", Filter[int](New(list), all), hint{0, 5, true}},
+		{"Chained", Chain[int](New(list), New(list)), hint{10, 10, true}},
+		{"Skipped", Skip[int](New(list), 2), hint{3, 3, true}},
+		{"Skipped past end", Skip[int](New(list), 7), hint{0, 0, true}},
+		{"Taken", Take[int](New(list), 2), hint{2, 2, true}},
+		{"Taken past end", Take[int](New(list), 7), hint{5, 5, true}},
+		{"Taken unbounded", Take[int](Flatten[int](New([]Iterable[int]{})), 2), hint{0, 2, true}},
+		{"Stepped", StepBy[int](New(list), 2), hint{3, 3, true}},
+		{"Stepped exact", StepBy[int](New(list[:4]), 2), hint{2, 2, true}},
+		{"TakeWhile", TakeWhile[int](New(list), all), hint{0, 5, true}},
+		{"SkipWhile", SkipWhile[int](New(list), all), hint{0, 5, true}},
+		{"Flat", Flatten[int](New([]Iterable[int]{New(list)})), hint{0, 0, false}},
+		{"Chained unbounded", Chain[int](New(list), Flatten[int](New([]Iterable[int]{}))), hint{5, 0, false}},
+	}
+
+	for _, c := range cases {
+		var have hint
+		have.lower, have.upper, have.ok = SizeHint(c.it)
+
+		if have != c.want {
+			t.Errorf("%v SizeHint \n\thave %v\n\twant %v", c.name, have, c.want)
+		}
+	}
+}
+
+func TestSizeHint_consumed(t *testing.T) {
+	s := StepBy[int](New([]int{1, 2, 3, 4, 5, 6}), 2)
+
+	for want := 3; want >= 0; want-- {
+		n, ok := exactLen[int](s)
+		assertEq(t, ok, true)
+		assertEq(t, n, want)
+		s.Next()
+	}
+}
+
+func TestExactSize(t *testing.T) {
+	var it Iterable[int] = Map[int, int](New([]int{1, 2}), func(i int) int { return i })
+
+	if _, ok := it.(ExactSize); ok {
+		t.Errorf("expected adapters not to be ExactSize")
+	}
+	if _, ok := Iterable[int](New([]int{1, 2})).(ExactSize); !ok {
+		t.Errorf("expected slice iterators to be ExactSize")
+	}
+}
+
+func TestCount_drain(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5, 6, 7}
+	isOdd := func(i int) bool { return i%2 != 0 }
+
+	cases := []struct {
+		name string
+		it   func(Iterable[int]) Iterable[int]
+	}{
+		{"Taken", func(it Iterable[int]) Iterable[int] { return Take(it, 3) }},
+		{"Taken past end", func(it Iterable[int]) Iterable[int] { return Take(it, 9) }},
+		{"Skipped", func(it Iterable[int]) Iterable[int] { return Skip(it, 2) }},
+		{"Skipped past end", func(it Iterable[int]) Iterable[int] { return Skip(it, 9) }},
+		{"Stepped", func(it Iterable[int]) Iterable[int] { return StepBy(it, 3) }},
+		{"Stepped taken", func(it Iterable[int]) Iterable[int] { return Take(StepBy(Skip(it, 1), 2), 2) }},
+		{"Chained", func(it Iterable[int]) Iterable[int] { return Take(Chain(it, New(list)), 9) }},
+		{"Fused", func(it Iterable[int]) Iterable[int] { return Take(Fuse(it), 4) }},
+		{"Filtered", func(it Iterable[int]) Iterable[int] { return Take(Filter(it, isOdd), 2) }},
+	}
+
+	for _, c := range cases {
+		// counting must consume the same elements as iterating
+		drained, walked := New(list), New(list)
+		want := 0
+		for w := c.it(walked); w.Next() != nil; {
+			want += 1
+		}
+
+		assertEq(t, Count(c.it(drained)), want)
+		assertEq(t, drained.Len(), walked.Len())
+	}
+
+	// too many elements to count by iterating
+	huge := Take[int](StepBy[int](Skip[int](Range(0, math.MaxInt), 1), 3), math.MaxInt-1)
+	assertEq(t, Count[int](huge), (math.MaxInt-2)/3+1)
+}
+
+func TestCollect_prealloc(t *testing.T) {
+	list := []int{1, 2, 3, 4}
+
+	have := Collect[int](Map[int, int](New(list), func(i int) int { return i }))
+	assertEq(t, cap(have), len(list))
+}
+
+func TestCount_exact(t *testing.T) {
+	i := New([]int{1, 2, 3, 4})
+	i.Next()
+
+	assertEq(t, Count[int](i), 3)
+	assertEq(t, i.Next(), nil)
+
+	r := New([]int{1, 2, 3, 4}).Rev()
+	assertEq(t, Count[int](r), 4)
+	assertEq(t, r.Next(), nil)
+}
+
+func TestPartition_exact(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5}
+	isEven := func(a int) bool { return a%2 == 0 }
+
+	a, b := Partition[int](New(list), isEven)
+	wantA := []int{2, 4}
+	wantB := []int{1, 3, 5}
+
+	assertEq(t, len(a), len(wantA))
+	assertEq(t, len(b), len(wantB))
+	for i, v := range wantA {
+		assertEq(t, a[i], v)
+	}
+	for i, v := range wantB {
+		assertEq(t, b[i], v)
+	}
+
+	// appending to a must not clobber b
+	a = append(a, 6)
+	assertEq(t, b[0], 1)
+}
+
+func TestChanIterator_unregister(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	c := FromChan(ch)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	Collect[int](WithContext[int](ctx, c))
+
+	// exhausting the iterator unregisters it from the context, so cancelling
+	// the context afterwards no longer interrupts it
+	cancel()
+	select {
+	case <-c.interrupted:
+		t.Errorf("expected exhaustion to unregister the iterator from the context")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
@@ -24,6 +24,51 @@ func (iter *Iterator[T]) Next() *T {
 	return next
 }
 
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (iter *Iterator[T]) NextValue() (T, bool) {
+	if iter.idx >= len(iter.slice) {
+		var zero T
+		return zero, false
+	}
+
+	next := iter.slice[iter.idx]
+	iter.idx += 1
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (iter *Iterator[T]) NextBack() *T {
+	end := len(iter.slice) - 1
+	if iter.idx > end {
+		return nil
+	}
+
+	next := &iter.slice[end]
+	iter.slice = iter.slice[:end]
+
+	return next
+}
+
+// Len returns the number of elements remaining in the iterator.
+func (iter *Iterator[T]) Len() int {
+	return len(iter.slice) - iter.idx
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The underlying slice is shared between the clones.
+func (iter *Iterator[T]) Clone() Iterable[T] {
+	c := *iter
+	return &c
+}
+
 // Rev reverses the iteration order of this iterator
 func (iter *Iterator[T]) Rev() *RevIterator[T] {
 	var idx int
@@ -40,4 +85,18 @@ func (iter *Iterator[T]) Rev() *RevIterator[T] {
 	}
 }
 
-//go:generate go run ./cmd/gen/ -name Iterator -output iterator_ext_gen.go
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (iter *Iterator[T]) SizeHint() (int, int, bool) {
+	n := iter.Len()
+	return n, n, true
+}
+
+func (iter *Iterator[T]) drain(n int) (int, bool) {
+	n = min(n, iter.Len())
+	iter.idx += n
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (iter *Iterator[T]) Fused() {}
@@ -236,3 +236,11 @@ func ExampleIterator_Last() {
 	// Output:
 	// 5
 }
+
+func ExampleIterator_Cycle() {
+	i := iter.New([]int{1, 2}).Cycle().Take(5)
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [1 2 1 2 1]
+}
@@ -0,0 +1,236 @@
+package iter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DecodeError is an error decoding a JSON record.
+type DecodeError struct {
+	// Offset is the byte offset in the input of the start of the record. For
+	// malformed JSON in an array, where the start of the element cannot be
+	// found, it is the offset of the error.
+	Offset int64
+	// Err is the error returned by encoding/json.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("iter: decoding JSON record at offset %d: %v", e.Offset, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Decoded is an Iterable over the records decoded from a stream of JSON, which
+// ends at the first error.
+type Decoded[T any] struct {
+	// next decodes the next record, returning io.EOF at the end of the input.
+	next   func(*T) error
+	report func(*DecodeError)
+	skip   bool
+	// resync reports whether decoding may continue after malformed JSON.
+	resync bool
+	err    error
+	done   bool
+}
+
+// DecodeNDJSON creates an iterator over the records of newline delimited JSON
+// read from r, decoding each into a T.
+//
+// Each non-blank line must hold a single JSON value. Lines are read one at a
+// time, as records are requested.
+func DecodeNDJSON[T any](r io.Reader) *Decoded[T] {
+	br := bufio.NewReader(r)
+	var offset int64
+
+	next := func(val *T) error {
+		for {
+			line, err := br.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				return err
+			}
+			start := offset
+			offset += int64(len(line))
+
+			if len(bytes.TrimSpace(line)) != 0 {
+				if err := json.Unmarshal(line, val); err != nil {
+					return &DecodeError{start, err}
+				}
+
+				return nil
+			}
+			if err == io.EOF {
+				return err
+			}
+		}
+	}
+
+	return &Decoded[T]{next: next, resync: true}
+}
+
+// DecodeJSONArray creates an iterator over the elements of a JSON array read
+// from r, decoding each into a T.
+//
+// Elements are read one at a time, as they are requested, so the array need
+// not fit in memory. Input following the array is not read.
+func DecodeJSONArray[T any](r io.Reader) *Decoded[T] {
+	er := &errReader{r: r}
+	dec := json.NewDecoder(er)
+	started := false
+
+	decode := func(val *T) error {
+		if !started {
+			started = true
+
+			tok, err := dec.Token()
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			if err != nil {
+				return &DecodeError{0, err}
+			}
+			if tok != json.Delim('[') {
+				return fmt.Errorf("iter: expected JSON array, found %v", tok)
+			}
+		}
+
+		if !dec.More() {
+			// consume the closing bracket, reporting a truncated array
+			start := dec.InputOffset()
+			if _, err := dec.Token(); err != nil {
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				return &DecodeError{start, err}
+			}
+
+			return io.EOF
+		}
+
+		// decode the raw element first, to find where it starts
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			offset := dec.InputOffset()
+			if syntaxErr, ok := err.(*json.SyntaxError); ok {
+				offset = syntaxErr.Offset
+			}
+			return &DecodeError{offset, err}
+		}
+
+		start := dec.InputOffset() - int64(len(raw))
+		if err := json.Unmarshal(raw, val); err != nil {
+			return &DecodeError{start, err}
+		}
+
+		return nil
+	}
+
+	next := func(val *T) error {
+		err := decode(val)
+		// the decoder does not distinguish failing to read from failing to
+		// decode, but reading cannot be skipped
+		if er.err != nil {
+			return er.err
+		}
+
+		return err
+	}
+
+	return &Decoded[T]{next: next}
+}
+
+// errReader records the first error other than io.EOF returned by a reader.
+type errReader struct {
+	r   io.Reader
+	err error
+}
+
+func (e *errReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	if err != nil && err != io.EOF && e.err == nil {
+		e.err = err
+	}
+
+	return n, err
+}
+
+// SkipInvalid makes the iterator skip records that cannot be decoded into a T,
+// rather than ending iteration, and returns the iterator.
+//
+// report, if not nil, is called with the error for each record skipped.
+// Malformed JSON ends iteration of an array regardless, as the start of the
+// next element cannot be found; each line of NDJSON is independent, so
+// malformed lines are skipped too.
+func (d *Decoded[T]) SkipInvalid(report func(*DecodeError)) *Decoded[T] {
+	d.skip = true
+	d.report = report
+	return d
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if a record could not be decoded.
+func (d *Decoded[T]) Next() *T {
+	next, ok := d.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished, or if a record
+// could not be decoded.
+func (d *Decoded[T]) NextValue() (T, bool) {
+	for !d.done {
+		var next T
+		err := d.next(&next)
+		if err == nil {
+			return next, true
+		}
+
+		var decErr *DecodeError
+		if d.skip && errors.As(err, &decErr) && (d.resync || !malformed(decErr.Err)) {
+			if d.report != nil {
+				d.report(decErr)
+			}
+			continue
+		}
+
+		d.done = true
+		if err != io.EOF {
+			d.err = err
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// malformed reports whether err was caused by malformed or truncated JSON,
+// rather than JSON that does not match the type decoded into.
+func malformed(err error) bool {
+	var syntaxErr *json.SyntaxError
+	return errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// Err returns the error that ended iteration, if any. Reaching the end of the
+// input is not an error.
+//
+// Errors decoding a record are of type *DecodeError; errors reading the input
+// are returned as is.
+func (d *Decoded[T]) Err() error {
+	return d.err
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (d *Decoded[T]) Fused() {}
@@ -0,0 +1,145 @@
+package iter_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/partylich/go/iter"
+)
+
+type record struct {
+	ID   int    `json:"id"`
+	Kind string `json:"kind"`
+}
+
+func ExampleDecodeNDJSON() {
+	r := strings.NewReader(`{"id": 1, "kind": "a"}
+{"id": 2, "kind": "b"}
+
+{"id": 3, "kind": "b"}
+`)
+	isB := func(rec record) bool { return rec.Kind == "b" }
+
+	fmt.Println(iter.DecodeNDJSON[record](r).Filter(isB).Take(1).Collect())
+	// Output:
+	// [{2 b}]
+}
+
+func ExampleDecodeJSONArray() {
+	r := strings.NewReader(`[1, 2, 3, 4]`)
+
+	fmt.Println(iter.DecodeJSONArray[int](r).Skip(1).Collect())
+	// Output:
+	// [2 3 4]
+}
+
+func ExampleDecoded_SkipInvalid() {
+	r := strings.NewReader("{\"id\": 1}\nnot json\n{\"id\": \"two\"}\n{\"id\": 3}\n")
+	report := func(err *iter.DecodeError) { fmt.Println("skipped record at offset", err.Offset) }
+
+	fmt.Println(iter.DecodeNDJSON[record](r).SkipInvalid(report).Collect())
+	// Output:
+	// skipped record at offset 10
+	// skipped record at offset 19
+	// [{1 } {3 }]
+}
+
+func TestDecodeNDJSON_err(t *testing.T) {
+	r := strings.NewReader("{\"id\": 1}\r\n{\"id\": 2\n{\"id\": 3}")
+	d := iter.DecodeNDJSON[record](r)
+
+	have := d.Collect()
+	if want := []record{{ID: 1}}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+
+	var decErr *iter.DecodeError
+	if !errors.As(d.Err(), &decErr) || decErr.Offset != 11 {
+		t.Errorf("Err \n\thave %v\n\twant a DecodeError at offset %v", d.Err(), 11)
+	}
+}
+
+func TestDecodeNDJSON_readErr(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("{\"id\": 1}\n{\"id\""), iotest.ErrReader(errRead))
+	d := iter.DecodeNDJSON[record](r).SkipInvalid(nil)
+
+	if n := d.Count(); n != 1 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 1)
+	}
+	if err := d.Err(); err != errRead {
+		t.Errorf("Err \n\thave %v\n\twant %v", err, errRead)
+	}
+}
+
+func TestDecodeJSONArray(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		skip   bool
+		want   []int
+		offset int64
+		err    bool
+	}{
+		"empty array":  {input: `[]`, want: nil},
+		"whitespace":   {input: " [ 1 ,\n2 ] ", want: []int{1, 2}},
+		"trailing":     {input: `[1] [2]`, want: []int{1}},
+		"no input":     {input: ``, err: true},
+		"not an array": {input: `{"a": 1}`, err: true},
+		"truncated":    {input: `[1, 2`, want: []int{1, 2}, offset: 5, err: true},
+		"type error":   {input: `[1, "two", 3]`, want: []int{1}, offset: 4, err: true},
+		"skip type":    {input: `[1, "two", 3]`, skip: true, want: []int{1, 3}},
+		"skip syntax":  {input: `[1, tw0, 3]`, skip: true, want: []int{1}, offset: 6, err: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := iter.DecodeJSONArray[int](strings.NewReader(tc.input))
+			if tc.skip {
+				d.SkipInvalid(nil)
+			}
+
+			if have := d.Collect(); !slices.Equal(have, tc.want) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, tc.want)
+			}
+
+			err := d.Err()
+			if (err != nil) != tc.err {
+				t.Fatalf("Err \n\thave %v\n\twant error %v", err, tc.err)
+			}
+
+			var decErr *iter.DecodeError
+			if errors.As(err, &decErr) && decErr.Offset != tc.offset {
+				t.Errorf("Offset \n\thave %v\n\twant %v", decErr.Offset, tc.offset)
+			}
+		})
+	}
+}
+
+func TestDecodeJSONArray_readErr(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader(`[1, 2, `), iotest.ErrReader(errRead))
+	d := iter.DecodeJSONArray[int](r).SkipInvalid(nil)
+
+	if n := d.Count(); n != 2 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 2)
+	}
+	if err := d.Err(); err != errRead {
+		t.Errorf("Err \n\thave %v\n\twant %v", err, errRead)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	d := iter.DecodeJSONArray[int](strings.NewReader(`["x"]`))
+	d.Next()
+
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(d.Err(), &typeErr) {
+		t.Errorf("Err \n\thave %v\n\twant a wrapped %T", d.Err(), typeErr)
+	}
+}
@@ -0,0 +1,144 @@
+package iter
+
+import "container/list"
+
+// ListCursor is a bidirectional iterator over a container/list, able to modify
+// the list as it goes.
+//
+// The cursor lies between two elements of the list, or at one of its ends.
+// Next returns the element after the cursor and Prev the element before it,
+// each moving the cursor past the element returned. Elements whose values do
+// not conform to the specified type are skipped.
+type ListCursor[T any] struct {
+	list *list.List
+	// next is the element after the cursor, or nil at the back of the list.
+	next *list.Element
+	// last is the element most recently returned by Next or Prev, or nil.
+	last *list.Element
+}
+
+// NewListCursor creates a cursor positioned at the front of the provided
+// container/list.
+func NewListCursor[T any](l *list.List) *ListCursor[T] {
+	return &ListCursor[T]{l, l.Front(), nil}
+}
+
+// FromListBack creates a new lazy iterator over the provided container/list,
+// from back to front.
+func FromListBack[T any](l *list.List) *Reversed[T] {
+	return Rev[T](FromList[T](l))
+}
+
+// Next advances the cursor and returns the next value.
+//
+// Returns nil when the cursor is at the back of the list.
+func (c *ListCursor[T]) Next() *T {
+	for e := c.next; e != nil; e = e.Next() {
+		if el, ok := e.Value.(T); ok {
+			c.next = e.Next()
+			c.last = e
+			return &el
+		}
+	}
+
+	c.next = nil
+	return nil
+}
+
+// Prev moves the cursor backward and returns the previous value.
+//
+// Returns nil when the cursor is at the front of the list.
+func (c *ListCursor[T]) Prev() *T {
+	e := c.list.Back()
+	if c.next != nil {
+		e = c.next.Prev()
+	}
+
+	for ; e != nil; e = e.Prev() {
+		if el, ok := e.Value.(T); ok {
+			c.next = e
+			c.last = e
+			return &el
+		}
+	}
+
+	c.next = c.list.Front()
+	return nil
+}
+
+// Element returns the list element most recently returned by Next or Prev, or
+// nil if there is none or it has been removed.
+func (c *ListCursor[T]) Element() *list.Element {
+	return c.last
+}
+
+// Remove removes the element most recently returned by Next or Prev from the
+// list, and reports whether there was one to remove.
+//
+// The cursor remains between the same neighbouring elements, so iteration
+// continues unaffected in either direction.
+func (c *ListCursor[T]) Remove() bool {
+	if c.last == nil {
+		return false
+	}
+
+	if c.next == c.last {
+		c.next = c.last.Next()
+	}
+	c.list.Remove(c.last)
+	c.last = nil
+
+	return true
+}
+
+// InsertBefore inserts v into the list immediately before the cursor, and
+// returns the new element.
+//
+// The new element will be returned by the next call to Prev, but not by Next.
+func (c *ListCursor[T]) InsertBefore(v T) *list.Element {
+	if c.next == nil {
+		return c.list.PushBack(v)
+	}
+
+	return c.list.InsertBefore(v, c.next)
+}
+
+// InsertAfter inserts v into the list immediately after the cursor, and
+// returns the new element.
+//
+// The new element will be returned by the next call to Next.
+func (c *ListCursor[T]) InsertAfter(v T) *list.Element {
+	if c.next == nil {
+		c.next = c.list.PushBack(v)
+	} else {
+		c.next = c.list.InsertBefore(v, c.next)
+	}
+
+	return c.next
+}
+
+// MoveToFront moves the element most recently returned by Next or Prev to the
+// front of the list, and reports whether there was one to move.
+//
+// As with Remove, the cursor remains between the same neighbouring elements.
+func (c *ListCursor[T]) MoveToFront() bool {
+	if c.last == nil {
+		return false
+	}
+
+	if c.next == c.last {
+		c.next = c.last.Next()
+	}
+	c.list.MoveToFront(c.last)
+
+	return true
+}
+
+// Seek moves the cursor to immediately before e, so that the next call to Next
+// returns it. A nil e moves the cursor to the back of the list.
+//
+// e must be an element of the cursor's list.
+func (c *ListCursor[T]) Seek(e *list.Element) {
+	c.next = e
+	c.last = nil
+}
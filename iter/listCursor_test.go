@@ -0,0 +1,165 @@
+package iter_test
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func newList(vals ...any) *list.List {
+	l := list.New()
+	for _, v := range vals {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+func listValues(l *list.List) []any {
+	var vals []any
+	for e := l.Front(); e != nil; e = e.Next() {
+		vals = append(vals, e.Value)
+	}
+
+	return vals
+}
+
+func ExampleNewListCursor() {
+	l := newList(1, 2, 3, 4)
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	c := iter.NewListCursor[int](l)
+	for n := c.Next(); n != nil; n = c.Next() {
+		if isEven(*n) {
+			c.Remove()
+		}
+	}
+
+	fmt.Println(listValues(l))
+	// Output:
+	// [1 3]
+}
+
+func ExampleFromListBack() {
+	fmt.Println(iter.FromListBack[int](newList(1, 2, 3)).Collect())
+	// Output:
+	// [3 2 1]
+}
+
+func ExampleListCursor_MoveToFront() {
+	l := newList("a", "b", "c")
+	c := iter.NewListCursor[string](l)
+
+	// mark "b" as most recently used
+	c.Find(func(s string) bool { return s == "b" })
+	c.MoveToFront()
+
+	fmt.Println(listValues(l))
+	// Output:
+	// [b a c]
+}
+
+func TestListCursor(t *testing.T) {
+	l := newList(1, "skip", 2, 3)
+	c := iter.NewListCursor[int](l)
+
+	if have := c.Prev(); have != nil {
+		t.Errorf("Prev \n\thave %v\n\twant <nil>", *have)
+	}
+
+	var have []int
+	for _, step := range []func() *int{c.Next, c.Next, c.Prev, c.Prev, c.Next, c.Next, c.Next, c.Next} {
+		if next := step(); next != nil {
+			have = append(have, *next)
+		} else {
+			have = append(have, 0)
+		}
+	}
+
+	if want := []int{1, 2, 2, 1, 1, 2, 3, 0}; !slices.Equal(have, want) {
+		t.Errorf("steps \n\thave %v\n\twant %v", have, want)
+	}
+
+	if have := *c.Prev(); have != 3 {
+		t.Errorf("Prev \n\thave %v\n\twant %v", have, 3)
+	}
+}
+
+func TestListCursor_Remove(t *testing.T) {
+	l := newList(1, 2, 3)
+	c := iter.NewListCursor[int](l)
+
+	if c.Remove() {
+		t.Errorf("Remove before Next \n\thave %v\n\twant %v", true, false)
+	}
+
+	c.Next()
+	c.Next()
+	c.Prev()
+	if !c.Remove() {
+		t.Errorf("Remove \n\thave %v\n\twant %v", false, true)
+	}
+	if c.Remove() {
+		t.Errorf("second Remove \n\thave %v\n\twant %v", true, false)
+	}
+
+	if have, want := listValues(l), []any{1, 3}; !slices.Equal(have, want) {
+		t.Errorf("list \n\thave %v\n\twant %v", have, want)
+	}
+	if have := *c.Next(); have != 3 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 3)
+	}
+	c.Prev()
+	if have := *c.Prev(); have != 1 {
+		t.Errorf("Prev \n\thave %v\n\twant %v", have, 1)
+	}
+}
+
+func TestListCursor_Insert(t *testing.T) {
+	l := newList(1, 4)
+	c := iter.NewListCursor[int](l)
+
+	c.Next()
+	c.InsertBefore(0)
+	c.InsertAfter(3)
+	c.InsertAfter(2)
+
+	if have := *c.Next(); have != 2 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 2)
+	}
+
+	c.Seek(nil)
+	c.InsertBefore(6)
+	c.InsertAfter(5)
+	if have := *c.Next(); have != 5 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 5)
+	}
+
+	if have, want := listValues(l), []any{1, 0, 2, 3, 4, 6, 5}; !slices.Equal(have, want) {
+		t.Errorf("list \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestListCursor_Seek(t *testing.T) {
+	l := newList(1, 2, 3)
+	c := iter.NewListCursor[int](l)
+
+	c.Seek(l.Back())
+	if have := *c.Next(); have != 3 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 3)
+	}
+	if e := c.Element(); e != l.Back() {
+		t.Errorf("Element \n\thave %v\n\twant %v", e.Value, 3)
+	}
+
+	c.MoveToFront()
+	if have, want := listValues(l), []any{3, 1, 2}; !slices.Equal(have, want) {
+		t.Errorf("list \n\thave %v\n\twant %v", have, want)
+	}
+	if have := c.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+}
@@ -3,14 +3,23 @@ package iter
 import "container/list"
 
 // ListIterator is a lazy iterator over a container/list
+//
+// The list may be modified during iteration. Elements pushed to the back of the
+// list are reached by Next, up to the first call to NextBack, which fixes the
+// back of the iteration at the last element of the list at that time.
+// Iteration finishes once the cursors meet, or either moves past an element
+// that has been removed from the list.
 type ListIterator[T any] struct {
-	list *list.List
-	el   *list.Element
+	list        *list.List
+	front, back *list.Element
+	// backSet reports whether back has been fixed by a call to NextBack.
+	backSet bool
+	done    bool
 }
 
 // New creates a new lazy iterator over the provided container/list
 func FromList[T any](list *list.List) *ListIterator[T] {
-	return &ListIterator[T]{list, list.Front()}
+	return &ListIterator[T]{list: list, front: list.Front()}
 }
 
 // Next advances the iterator and returns the next value.
@@ -18,18 +27,77 @@ func FromList[T any](list *list.List) *ListIterator[T] {
 // Returns nil when iteration is finished, or if the next value does not conform
 // to the specified type.
 func (it *ListIterator[T]) Next() *T {
-	if it.el == nil {
+	el, ok := it.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &el
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (it *ListIterator[T]) NextValue() (T, bool) {
+	if it.done || it.front == nil {
+		it.done = true
+
+		var zero T
+		return zero, false
+	}
+
+	el, ok := it.front.Value.(T)
+	if !ok {
+		return el, false
+	}
+
+	if it.backSet && it.front == it.back {
+		it.done = true
+	}
+	it.front = it.front.Next()
+
+	return el, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished, or if the value does not conform to
+// the specified type.
+func (it *ListIterator[T]) NextBack() *T {
+	if !it.backSet {
+		it.backSet = true
+		if it.front != nil {
+			it.back = it.list.Back()
+		}
+	}
+
+	if it.done || it.back == nil {
+		it.done = true
 		return nil
 	}
 
-	el, ok := it.el.Value.(T)
+	el, ok := it.back.Value.(T)
 	if !ok {
 		return nil
 	}
 
-	it.el = it.el.Next()
+	if it.back == it.front {
+		it.done = true
+	}
+	it.back = it.back.Prev()
 
 	return &el
 }
 
-//go:generate go run ./cmd/gen/ -name ListIterator -output listIterator_ext_gen.go
+// Fused marks this iterator as a FusedIterable.
+func (it *ListIterator[T]) Fused() {}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The underlying list is shared between the clones.
+func (it *ListIterator[T]) Clone() Iterable[T] {
+	c := *it
+	return &c
+}
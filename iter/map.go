@@ -2,12 +2,13 @@ package iter
 
 type Mapped[T any, O any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	fn   func(T) O
 }
 
 // Map returns an iterator that applies a function to every element.
 func Map[T any, O any](iter Iterable[T], fn func(T) O) *Mapped[T, O] {
-	return &Mapped[T, O]{iter, fn}
+	return &Mapped[T, O]{iter, valueFunc(iter), fn}
 }
 
 // Next advances the iterator and returns the next value.
@@ -24,4 +25,61 @@ func (m *Mapped[T, O]) Next() *O {
 	return &result
 }
 
-//go:generate go run ./cmd/gen/ -name Mapped -otype O -output map_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (m *Mapped[T, O]) NextValue() (O, bool) {
+	next, ok := m.pull()
+	if !ok {
+		var zero O
+		return zero, false
+	}
+
+	return m.fn(next), true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (m *Mapped[T, O]) NextBack() *O {
+	next := back(m.iter).NextBack()
+
+	if next == nil {
+		return nil
+	}
+	result := m.fn(*next)
+
+	return &result
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended.
+func (m *Mapped[T, O]) DoubleEnded() bool {
+	return IsDoubleEnded(m.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (m *Mapped[T, O]) SizeHint() (int, int, bool) {
+	return SizeHint(m.iter)
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (m *Mapped[T, O]) Stop() {
+	stop(m.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The mapping function is shared between the clones.
+// Panics if Cloneable reports false.
+func (m *Mapped[T, O]) Clone() Iterable[O] {
+	return Map(clone(m.iter), m.fn)
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (m *Mapped[T, O]) Cloneable() bool {
+	return IsCloneable(m.iter)
+}
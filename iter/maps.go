@@ -0,0 +1,69 @@
+package iter
+
+import "slices"
+
+// Keys creates an iterator over the keys of a map.
+//
+// The keys are copied when Keys is called, so later changes to the map are not
+// seen by the iterator. The order is unspecified, as for ranging over the map;
+// use SortedKeys for a deterministic order.
+func Keys[M ~map[K]V, K comparable, V any](m M) *Iterator[K] {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return New(keys)
+}
+
+// Values creates an iterator over the values of a map.
+//
+// The values are copied when Values is called, and are yielded in an
+// unspecified order.
+func Values[M ~map[K]V, K comparable, V any](m M) *Iterator[V] {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return New(values)
+}
+
+// Entries creates an iterator over the key-value pairs of a map, with the key
+// as the First element of each Pair.
+//
+// The entries are copied when Entries is called, and are yielded in an
+// unspecified order; use SortedEntries for a deterministic order.
+func Entries[M ~map[K]V, K comparable, V any](m M) *Iterator[Pair[K, V]] {
+	entries := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Pair[K, V]{k, v})
+	}
+
+	return New(entries)
+}
+
+// SortedKeys creates an iterator over the keys of a map, in the order defined
+// by cmp.
+//
+// cmp returns a negative number when a < b, a positive number when a > b, and
+// zero when a == b, as for slices.SortFunc. cmp.Compare suits ordered keys.
+func SortedKeys[M ~map[K]V, K comparable, V any](m M, cmp func(a, b K) int) *Iterator[K] {
+	it := Keys(m)
+	slices.SortFunc(it.slice, cmp)
+
+	return it
+}
+
+// SortedEntries creates an iterator over the key-value pairs of a map, ordered
+// by key as defined by cmp.
+//
+// cmp compares keys as described for SortedKeys.
+func SortedEntries[M ~map[K]V, K comparable, V any](m M, cmp func(a, b K) int) *Iterator[Pair[K, V]] {
+	it := Entries(m)
+	slices.SortFunc(it.slice, func(a, b Pair[K, V]) int {
+		return cmp(a.First, b.First)
+	})
+
+	return it
+}
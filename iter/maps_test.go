@@ -0,0 +1,69 @@
+package iter_test
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleSortedKeys() {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	fmt.Println(iter.SortedKeys(m, cmp.Compare[string]).Collect())
+	// Output:
+	// [a b c]
+}
+
+func ExampleSortedEntries() {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+	gt1 := func(e iter.Pair[string, int]) bool { return e.Second > 1 }
+
+	for e := range iter.SortedEntries(m, cmp.Compare[string]).Filter(gt1).Seq() {
+		fmt.Println(e.First, e.Second)
+	}
+	// Output:
+	// b 2
+	// c 3
+}
+
+func TestMaps(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b"}
+
+	keys := iter.Keys(m).Collect()
+	slices.Sort(keys)
+	if want := []int{1, 2, 3}; !slices.Equal(keys, want) {
+		t.Errorf("Keys \n\thave %v\n\twant %v", keys, want)
+	}
+
+	values := iter.Values(m).Collect()
+	slices.Sort(values)
+	if want := []string{"a", "b", "c"}; !slices.Equal(values, want) {
+		t.Errorf("Values \n\thave %v\n\twant %v", values, want)
+	}
+
+	for _, e := range iter.Entries(m).Collect() {
+		if m[e.First] != e.Second {
+			t.Errorf("Entries \n\thave %v\n\twant %v", e, iter.Pair[int, string]{e.First, m[e.First]})
+		}
+	}
+
+	desc := func(a, b int) int { return cmp.Compare(b, a) }
+	have := iter.SortedEntries(m, desc).Collect()
+	want := []iter.Pair[int, string]{{3, "c"}, {2, "b"}, {1, "a"}}
+	if !slices.Equal(have, want) {
+		t.Errorf("SortedEntries \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestKeys_snapshot(t *testing.T) {
+	m := map[int]bool{1: true}
+	keys := iter.Keys(m)
+	m[2] = true
+
+	if n := keys.Len(); n != 1 {
+		t.Errorf("Len \n\thave %v\n\twant %v", n, 1)
+	}
+}
@@ -0,0 +1,172 @@
+package iter
+
+// PeekableT is an Iterable with a Peek method that returns an optional
+// reference to the next element without consuming it.
+type PeekableT[T any] struct {
+	iter   Iterable[T]
+	pull   func() (T, bool)
+	peeked bool
+	value  *T
+}
+
+// Peekable creates an iterator which can use the Peek and PeekMut methods to
+// look at the next element of the iterator without consuming it.
+//
+// Note that the underlying iterator is still advanced when Peek is called for
+// the first time: in order to retrieve the next element, Next is called on the
+// underlying iterator.
+func Peekable[T any](iter Iterable[T]) *PeekableT[T] {
+	return &PeekableT[T]{iter, valueFunc(iter), false, nil}
+}
+
+// peek buffers a copy of the next element of the underlying iterator, if one
+// is not already buffered.
+func (p *PeekableT[T]) peek() *T {
+	if !p.peeked {
+		p.peeked = true
+		p.value = nil
+
+		if next := p.iter.Next(); next != nil {
+			value := *next
+			p.value = &value
+		}
+	}
+
+	return p.value
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *PeekableT[T]) Next() *T {
+	if p.peeked {
+		p.peeked = false
+		return p.value
+	}
+
+	return p.iter.Next()
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *PeekableT[T]) NextValue() (T, bool) {
+	if !p.peeked {
+		return p.pull()
+	}
+
+	p.peeked = false
+	if p.value == nil {
+		var zero T
+		return zero, false
+	}
+
+	return *p.value, true
+}
+
+// Peek returns the next value without advancing the iterator.
+//
+// Returns nil when iteration is finished. The returned value is a copy;
+// modifying it does not affect the iterator.
+func (p *PeekableT[T]) Peek() *T {
+	next := p.peek()
+	if next == nil {
+		return nil
+	}
+
+	value := *next
+	return &value
+}
+
+// PeekMut returns the next value without advancing the iterator.
+//
+// Returns nil when iteration is finished. Unlike Peek, modifications made
+// through the returned pointer are seen by the next call to Next.
+func (p *PeekableT[T]) PeekMut() *T {
+	return p.peek()
+}
+
+// NextIf consumes and returns the next value of this iterator if pred returns
+// true for it.
+//
+// Returns nil, without advancing the iterator, if pred returns false or
+// iteration is finished.
+func (p *PeekableT[T]) NextIf(pred func(T) bool) *T {
+	next := p.peek()
+	if next == nil || !pred(*next) {
+		return nil
+	}
+
+	return p.Next()
+}
+
+// NextIfEq consumes and returns the next value of a Peekable iterator if it is
+// equal to v.
+//
+// Returns nil, without advancing the iterator, if the next value is not equal
+// to v or iteration is finished.
+func NextIfEq[T comparable](p *PeekableT[T], v T) *T {
+	return p.NextIf(func(t T) bool { return t == v })
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (p *PeekableT[T]) NextBack() *T {
+	if p.peeked && p.value == nil {
+		return nil
+	}
+
+	if next := back(p.iter).NextBack(); next != nil {
+		return next
+	}
+
+	// the buffered element is the last one remaining
+	return p.Next()
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended.
+func (p *PeekableT[T]) DoubleEnded() bool {
+	return IsDoubleEnded(p.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (p *PeekableT[T]) SizeHint() (int, int, bool) {
+	if !p.peeked {
+		return SizeHint(p.iter)
+	}
+	if p.value == nil {
+		return 0, 0, true
+	}
+
+	lower, upper, ok := SizeHint(p.iter)
+	return addHint(lower, upper, ok, 1, 1, true)
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (p *PeekableT[T]) Stop() {
+	stop(p.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (p *PeekableT[T]) Clone() Iterable[T] {
+	c := Peekable(clone(p.iter))
+	c.peeked = p.peeked
+	if p.value != nil {
+		value := *p.value
+		c.value = &value
+	}
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (p *PeekableT[T]) Cloneable() bool {
+	return IsCloneable(p.iter)
+}
@@ -0,0 +1,112 @@
+package iter_test
+
+import (
+	"container/list"
+	"fmt"
+	"testing"
+	"unicode"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExamplePeekable() {
+	i := iter.Peekable[int](iter.New([]int{1, 2, 3}))
+
+	fmt.Println(*i.Peek())
+	fmt.Println(*i.Next())
+	fmt.Println(*i.Next())
+	// peeking does not advance the iterator
+	fmt.Println(*i.Peek())
+	fmt.Println(*i.Peek())
+	fmt.Println(*i.Next())
+	fmt.Println(i.Peek())
+	// Output:
+	// 1
+	// 1
+	// 2
+	// 3
+	// 3
+	// 3
+	// <nil>
+}
+
+func ExamplePeekableT_PeekMut() {
+	i := iter.New([]int{1, 2, 3}).Peekable()
+
+	*i.PeekMut() = 10
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [10 2 3]
+}
+
+func ExamplePeekableT_NextIf() {
+	isDigit := func(r rune) bool { return unicode.IsDigit(r) }
+	i := iter.New([]rune("42 apples")).Peekable()
+
+	n := 0
+	for d := i.NextIf(isDigit); d != nil; d = i.NextIf(isDigit) {
+		n = n*10 + int(*d-'0')
+	}
+
+	fmt.Println(n)
+	fmt.Printf("%q\n", *i.Next())
+	// Output:
+	// 42
+	// ' '
+}
+
+func ExampleNextIfEq() {
+	i := iter.New([]int{0, 0, 1}).Peekable()
+
+	fmt.Println(*iter.NextIfEq(i, 0))
+	fmt.Println(*iter.NextIfEq(i, 0))
+	fmt.Println(iter.NextIfEq(i, 0))
+	fmt.Println(*i.Next())
+	// Output:
+	// 0
+	// 0
+	// <nil>
+	// 1
+}
+
+func ExamplePeekableT_mapped() {
+	l := list.New()
+	for i := 1; i <= 3; i++ {
+		l.PushBack(i)
+	}
+	double := func(n int) int { return n * 2 }
+
+	m := iter.Map[int, int](iter.FromList[int](l), double).Peekable()
+
+	fmt.Println(*m.Peek())
+	fmt.Println(m.Collect())
+	// Output:
+	// 2
+	// [2 4 6]
+}
+
+func TestPeekable_NextBack(t *testing.T) {
+	p := iter.Peekable[int](iter.New([]int{1, 2, 3}))
+
+	p.Peek()
+	if have := *p.NextBack(); have != 3 {
+		t.Errorf("NextBack \n\thave %v\n\twant %v", have, 3)
+	}
+	if have, _, _ := p.SizeHint(); have != 2 {
+		t.Errorf("SizeHint \n\thave %v\n\twant %v", have, 2)
+	}
+	if have := *p.NextBack(); have != 2 {
+		t.Errorf("NextBack \n\thave %v\n\twant %v", have, 2)
+	}
+	// the peeked element is all that remains
+	if have := *p.NextBack(); have != 1 {
+		t.Errorf("NextBack \n\thave %v\n\twant %v", have, 1)
+	}
+	if have := p.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if have, _, _ := p.SizeHint(); have != 0 {
+		t.Errorf("SizeHint \n\thave %v\n\twant %v", have, 0)
+	}
+}
@@ -0,0 +1,363 @@
+package iter
+
+import "math"
+
+// Product is an Iterable over the Cartesian product of two iterators.
+type Product[T any, U any] struct {
+	a     Iterable[T]
+	b     Iterable[U]
+	pullA func() (T, bool)
+	pullB func() (U, bool)
+	// buf holds the elements of b read so far.
+	buf   []U
+	bDone bool
+	// cur is the element of a being paired, if hasCur.
+	cur    T
+	hasCur bool
+	// i is the index in buf of the next element to pair with cur.
+	i    int
+	done bool
+}
+
+// Product2 creates an iterator over every pair of an element of a with an
+// element of b.
+//
+// Pairs are yielded in odometer order: the second element varies fastest, so
+// each element of a is paired with every element of b before moving to the
+// next. Both iterators are read lazily; the elements of b are buffered as they
+// are read, to be paired again with later elements of a. If b is empty, a is
+// not read beyond its first element.
+func Product2[T any, U any](a Iterable[T], b Iterable[U]) *Product[T, U] {
+	return &Product[T, U]{a: a, b: b, pullA: valueFunc(a), pullB: valueFunc(b)}
+}
+
+// Product3T is an Iterable over the Cartesian product of three iterators.
+type Product3T[T any, U any, V any] struct {
+	p *Product[Pair[T, U], V]
+}
+
+// Product3 creates an iterator over every combination of an element of a, an
+// element of b and an element of c, in odometer order.
+//
+// The elements of b and c are buffered as described for Product2.
+func Product3[T any, U any, V any](a Iterable[T], b Iterable[U], c Iterable[V]) *Product3T[T, U, V] {
+	return &Product3T[T, U, V]{Product2[Pair[T, U], V](Product2(a, b), c)}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *Product[T, U]) Next() *Pair[T, U] {
+	next, ok := p.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *Product[T, U]) NextValue() (Pair[T, U], bool) {
+	for !p.done {
+		if !p.hasCur {
+			p.cur, p.hasCur = p.pullA()
+			if !p.hasCur {
+				break
+			}
+			p.i = 0
+		}
+
+		if p.i == len(p.buf) && !p.bDone {
+			if next, ok := p.pullB(); ok {
+				p.buf = append(p.buf, next)
+			} else {
+				p.bDone = true
+			}
+		}
+
+		if p.i < len(p.buf) {
+			next := Pair[T, U]{p.cur, p.buf[p.i]}
+			p.i += 1
+
+			return next, true
+		}
+
+		p.hasCur = false
+		if len(p.buf) == 0 {
+			break
+		}
+	}
+
+	p.done = true
+	return Pair[T, U]{}, false
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The length is only known once the second iterator has been read in full.
+func (p *Product[T, U]) SizeHint() (int, int, bool) {
+	if p.done {
+		return 0, 0, true
+	}
+	if !p.bDone {
+		return 0, 0, false
+	}
+
+	n := len(p.buf)
+	cur := 0
+	if p.hasCur {
+		cur = n - p.i
+	}
+
+	loA, hiA, okA := SizeHint(p.a)
+	lower, okLo := mulCount(loA, n)
+	upper, okHi := mulCount(hiA, n)
+	if !okLo {
+		return math.MaxInt, 0, false
+	}
+
+	return addHint(lower, upper, okA && okHi, cur, cur, true)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (p *Product[T, U]) Fused() {}
+
+// Stop stops both underlying iterators, if they are Stoppers.
+func (p *Product[T, U]) Stop() {
+	stop(p.a)
+	stop(p.b)
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *Product3T[T, U, V]) Next() *Triple[T, U, V] {
+	next, ok := p.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *Product3T[T, U, V]) NextValue() (Triple[T, U, V], bool) {
+	next, ok := p.p.NextValue()
+	if !ok {
+		return Triple[T, U, V]{}, false
+	}
+
+	return Triple[T, U, V]{next.First.First, next.First.Second, next.Second}, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The length is only known once the second and third iterators have been read
+// in full.
+func (p *Product3T[T, U, V]) SizeHint() (int, int, bool) {
+	return p.p.SizeHint()
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (p *Product3T[T, U, V]) Fused() {}
+
+// Stop stops the underlying iterators, if they are Stoppers.
+func (p *Product3T[T, U, V]) Stop() {
+	p.p.Stop()
+}
+
+// ProductNT is an Iterable over the Cartesian product of any number of
+// iterators of the same type.
+type ProductNT[T any] struct {
+	iters []Iterable[T]
+	pulls []func() (T, bool)
+	// bufs holds the elements read so far from each iterator but the first.
+	bufs  [][]T
+	eof   []bool
+	idx   []int
+	cur   []T
+	first bool
+	done  bool
+}
+
+// ProductN creates an iterator over every combination of one element from
+// each of iters, in odometer order: the element from the last iterator varies
+// fastest.
+//
+// Each combination is a new slice. The iterators are read lazily; the elements
+// of all but the first are buffered as they are read. If iters is empty, a
+// single empty combination is yielded, and if any iterator is empty, there are
+// no combinations.
+func ProductN[T any](iters []Iterable[T]) *ProductNT[T] {
+	n := len(iters)
+	pulls := make([]func() (T, bool), n)
+	for j, it := range iters {
+		pulls[j] = valueFunc(it)
+	}
+
+	return &ProductNT[T]{
+		iters: iters,
+		pulls: pulls,
+		bufs:  make([][]T, n),
+		eof:   make([]bool, n),
+		idx:   make([]int, n),
+		cur:   make([]T, n),
+		first: true,
+	}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *ProductNT[T]) Next() *[]T {
+	next, ok := p.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *ProductNT[T]) NextValue() ([]T, bool) {
+	if p.done || !p.advance() {
+		p.done = true
+		return nil, false
+	}
+
+	next := make([]T, len(p.cur))
+	copy(next, p.cur)
+
+	return next, true
+}
+
+// advance moves the odometer to the next combination, reporting whether there
+// is one.
+func (p *ProductNT[T]) advance() bool {
+	if p.first {
+		p.first = false
+		for j := range p.iters {
+			if !p.step(j) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for j := len(p.iters) - 1; j >= 0; j-- {
+		if p.step(j) {
+			return true
+		}
+
+		// carry; every iterator but the first restarts from its buffer
+		if j == 0 {
+			return false
+		}
+		p.idx[j] = 0
+		p.cur[j] = p.bufs[j][0]
+	}
+
+	return false
+}
+
+// step moves position j to the next element of its iterator, reporting whether
+// there is one.
+func (p *ProductNT[T]) step(j int) bool {
+	if j == 0 {
+		next, ok := p.pulls[0]()
+		p.cur[0] = next
+
+		return ok
+	}
+
+	i := p.idx[j]
+	if p.bufs[j] != nil {
+		i += 1
+	}
+
+	if i == len(p.bufs[j]) && !p.eof[j] {
+		if next, ok := p.pulls[j](); ok {
+			p.bufs[j] = append(p.bufs[j], next)
+		} else {
+			p.eof[j] = true
+		}
+	}
+
+	if i >= len(p.bufs[j]) {
+		return false
+	}
+
+	p.idx[j] = i
+	p.cur[j] = p.bufs[j][i]
+
+	return true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The length is only known once every iterator but the first has been read in
+// full.
+func (p *ProductNT[T]) SizeHint() (int, int, bool) {
+	if p.done {
+		return 0, 0, true
+	}
+	if len(p.iters) == 0 {
+		if p.first {
+			return 1, 1, true
+		}
+		return 0, 0, true
+	}
+	if p.first {
+		return 0, 0, false
+	}
+	for j := 1; j < len(p.iters); j++ {
+		if !p.eof[j] {
+			return 0, 0, false
+		}
+	}
+
+	// cur is the number of combinations remaining with the current element of
+	// the first iterator, and per the number with each of its later elements
+	cur, per := 0, 1
+	for j := len(p.iters) - 1; j > 0; j-- {
+		n := len(p.bufs[j])
+		rest, ok := mulCount(n-1-p.idx[j], per)
+		if !ok {
+			return 0, 0, false
+		}
+		if per, ok = mulCount(per, n); !ok {
+			return 0, 0, false
+		}
+		// cur remains less than per, so this cannot overflow
+		cur += rest
+	}
+
+	loA, hiA, okA := SizeHint(p.iters[0])
+	lower, okLo := mulCount(loA, per)
+	upper, okHi := mulCount(hiA, per)
+	if !okLo {
+		return math.MaxInt, 0, false
+	}
+
+	return addHint(lower, upper, okA && okHi, cur, cur, true)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (p *ProductNT[T]) Fused() {}
+
+// Stop stops the underlying iterators, if they are Stoppers.
+func (p *ProductNT[T]) Stop() {
+	for _, it := range p.iters {
+		stop(it)
+	}
+}
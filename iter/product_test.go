@@ -0,0 +1,161 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleProduct2() {
+	p := iter.Product2[int, string](iter.New([]int{1, 2}), iter.New([]string{"a", "b"}))
+
+	fmt.Println(p.Collect())
+	// Output:
+	// [{1 a} {1 b} {2 a} {2 b}]
+}
+
+func ExampleProduct3() {
+	valid := func(t iter.Triple[int, int, int]) bool {
+		return t.First+t.Second+t.Third == 4
+	}
+	p := iter.Product3[int, int, int](iter.Range(0, 3), iter.Range(0, 3), iter.Range(0, 3))
+
+	fmt.Println(*p.Find(valid))
+	// Output:
+	// {0 2 2}
+}
+
+func ExampleProductN() {
+	p := iter.ProductN([]iter.Iterable[int]{
+		iter.New([]int{0, 1}),
+		iter.New([]int{0, 1}),
+		iter.New([]int{0, 1}),
+	})
+
+	fmt.Println(p.Collect())
+	// Output:
+	// [[0 0 0] [0 0 1] [0 1 0] [0 1 1] [1 0 0] [1 0 1] [1 1 0] [1 1 1]]
+}
+
+func TestProduct2(t *testing.T) {
+	p := iter.Product2[int, int](iter.Range(0, 3), iter.Range(0, 2))
+
+	p.Next()
+	if _, _, ok := p.SizeHint(); ok {
+		t.Errorf("SizeHint before inner exhausted \n\thave exact\n\twant inexact")
+	}
+
+	p.Next()
+	p.Next()
+	if lower, upper, ok := p.SizeHint(); lower != 3 || upper != 3 || !ok {
+		t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, 3, 3, true)
+	}
+
+	have := p.Collect()
+	want := []iter.Pair[int, int]{{1, 1}, {2, 0}, {2, 1}}
+	if !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestProduct2_empty(t *testing.T) {
+	a := iter.Range(0, 5)
+	p := iter.Product2[int, int](a, iter.Empty[int]())
+
+	if have := p.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if n := a.Len(); n != 4 {
+		t.Errorf("outer Len \n\thave %v\n\twant %v", n, 4)
+	}
+}
+
+func TestProduct2_lazy(t *testing.T) {
+	reads := 0
+	inner := iter.Map(iter.Range(0, 100), func(n int) int {
+		reads += 1
+		return n
+	})
+	p := iter.Product2[int, int](iter.Range(0, 100), inner)
+
+	p.Find(func(p iter.Pair[int, int]) bool { return p.Second == 3 })
+	if reads != 4 {
+		t.Errorf("inner reads \n\thave %v\n\twant %v", reads, 4)
+	}
+}
+
+func TestProduct3(t *testing.T) {
+	p := iter.Product3[int, string, bool](iter.Range(0, 2), iter.New([]string{"a"}), iter.New([]bool{true, false}))
+
+	if have, want := *p.Next(), (iter.Triple[int, string, bool]{0, "a", true}); have != want {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, want)
+	}
+	if _, _, ok := p.SizeHint(); ok {
+		t.Errorf("SizeHint before inner exhausted \n\thave exact\n\twant inexact")
+	}
+
+	p.Next()
+	p.Next()
+	if lower, upper, ok := p.SizeHint(); lower != 1 || upper != 1 || !ok {
+		t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, 1, 1, true)
+	}
+
+	have := p.Collect()
+	want := []iter.Triple[int, string, bool]{{1, "a", false}}
+	if !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestProductN(t *testing.T) {
+	tests := map[string]struct {
+		iters []iter.Iterable[int]
+		want  [][]int
+	}{
+		"none":   {nil, [][]int{{}}},
+		"single": {[]iter.Iterable[int]{iter.Range(0, 3)}, [][]int{{0}, {1}, {2}}},
+		"empty": {
+			[]iter.Iterable[int]{iter.Range(0, 3), iter.Empty[int](), iter.Range(0, 3)},
+			nil,
+		},
+		"uneven": {
+			[]iter.Iterable[int]{iter.Range(0, 2), iter.Range(0, 1), iter.Range(5, 8)},
+			[][]int{{0, 0, 5}, {0, 0, 6}, {0, 0, 7}, {1, 0, 5}, {1, 0, 6}, {1, 0, 7}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			have := iter.ProductN(tc.iters).Collect()
+			if !slices.EqualFunc(have, tc.want, slices.Equal[[]int]) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, tc.want)
+			}
+		})
+	}
+}
+
+func TestProductN_SizeHint(t *testing.T) {
+	p := iter.ProductN([]iter.Iterable[int]{iter.Range(0, 2), iter.Range(0, 2), iter.Range(0, 3)})
+
+	if _, _, ok := p.SizeHint(); ok {
+		t.Errorf("SizeHint before inner exhausted \n\thave exact\n\twant inexact")
+	}
+
+	// the inner iterators are exhausted once the second element of the first
+	// is reached
+	for range 7 {
+		p.Next()
+	}
+	for want := 5; want >= 0; want-- {
+		if lower, upper, ok := p.SizeHint(); lower != want || upper != want || !ok {
+			t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, want, want, true)
+		}
+		p.Next()
+	}
+
+	if lower, upper, ok := iter.ProductN[int](nil).SizeHint(); lower != 1 || upper != 1 || !ok {
+		t.Errorf("SizeHint none \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, 1, 1, true)
+	}
+}
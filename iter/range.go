@@ -0,0 +1,296 @@
+package iter
+
+import (
+	"math"
+
+	is "golang.org/x/exp/constraints"
+)
+
+// RangeIterator is an Iterable over an arithmetic progression of integers.
+type RangeIterator[T is.Integer] struct {
+	front T
+	step  T
+	// rem is the number of elements remaining after front. Counting from zero
+	// allows ranges spanning the whole of a 64-bit type.
+	rem  uint64
+	done bool
+}
+
+// Range creates an iterator over the integers from start up to, but not
+// including, end.
+//
+// The iterator is empty if end is not greater than start.
+func Range[T is.Integer](start, end T) *RangeIterator[T] {
+	if end <= start {
+		return &RangeIterator[T]{done: true}
+	}
+
+	return &RangeIterator[T]{start, 1, uint64(end) - uint64(start) - 1, false}
+}
+
+// RangeInclusive creates an iterator over the integers from start up to and
+// including end.
+//
+// The iterator is empty if end is less than start.
+func RangeInclusive[T is.Integer](start, end T) *RangeIterator[T] {
+	if end < start {
+		return &RangeIterator[T]{done: true}
+	}
+
+	return &RangeIterator[T]{start, 1, uint64(end) - uint64(start), false}
+}
+
+// RangeStep creates an iterator over the integers from start up to, but not
+// including, end, separated by step.
+//
+// A negative step counts down from start towards end. The iterator is empty if
+// end cannot be reached from start by moving in the direction of step.
+//
+// Panics if step is zero.
+func RangeStep[T is.Integer](start, end, step T) *RangeIterator[T] {
+	var dist, size uint64
+
+	switch {
+	case step > 0 && end > start:
+		dist = uint64(end) - uint64(start)
+		size = uint64(step)
+	case step < 0 && end < start:
+		dist = uint64(start) - uint64(end)
+		// negate after widening, so the minimum value of a signed type does not
+		// overflow
+		size = -uint64(step)
+	case step == 0:
+		panic("RangeStep step must not be zero")
+	default:
+		return &RangeIterator[T]{done: true}
+	}
+
+	return &RangeIterator[T]{start, step, (dist - 1) / size, false}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (r *RangeIterator[T]) Next() *T {
+	next, ok := r.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (r *RangeIterator[T]) NextValue() (T, bool) {
+	if r.done {
+		var zero T
+		return zero, false
+	}
+
+	next := r.front
+	if r.rem == 0 {
+		r.done = true
+	} else {
+		r.rem -= 1
+		r.front += r.step
+	}
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (r *RangeIterator[T]) NextBack() *T {
+	if r.done {
+		return nil
+	}
+
+	// intermediate products may wrap, but the result is always in range
+	next := r.front + T(r.rem)*r.step
+	if r.rem == 0 {
+		r.done = true
+	} else {
+		r.rem -= 1
+	}
+
+	return &next
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The length of a range too long to be counted by an int has no upper bound.
+func (r *RangeIterator[T]) SizeHint() (int, int, bool) {
+	switch {
+	case r.done:
+		return 0, 0, true
+	case r.rem >= math.MaxInt:
+		return math.MaxInt, 0, false
+	default:
+		n := int(r.rem) + 1
+		return n, n, true
+	}
+}
+
+// Len returns the number of elements remaining in the iterator.
+//
+// Panics if the length of the range is too long to be counted by an int.
+func (r *RangeIterator[T]) Len() int {
+	return lenOf[T](r)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (r *RangeIterator[T]) Clone() Iterable[T] {
+	c := *r
+	return &c
+}
+
+func (r *RangeIterator[T]) drain(n int) (int, bool) {
+	if r.done || n == 0 {
+		return 0, true
+	}
+	if uint64(n) > r.rem {
+		n = r.Len()
+		r.done = true
+
+		return n, true
+	}
+
+	// intermediate products may wrap, but the result is always in range
+	r.front += T(n) * r.step
+	r.rem -= uint64(n)
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *RangeIterator[T]) Fused() {}
+
+// SpaceIterator is an Iterable over a fixed number of evenly spaced floating
+// point numbers.
+type SpaceIterator[F is.Float] struct {
+	start, stop F
+	n           int
+	// base is the base of a logarithmic space, or zero for a linear one.
+	base        F
+	front, back int
+}
+
+// Linspace creates an iterator over n evenly spaced numbers from start to stop,
+// inclusive.
+//
+// A single number yields start. The final number is exactly stop, regardless of
+// rounding error in the spacing.
+//
+// Panics if n is negative.
+func Linspace[F is.Float](start, stop F, n int) *SpaceIterator[F] {
+	if n < 0 {
+		panic("Linspace n must not be negative")
+	}
+
+	return &SpaceIterator[F]{start, stop, n, 0, 0, n}
+}
+
+// Logspace creates an iterator over n numbers evenly spaced on a logarithmic
+// scale, from base raised to start to base raised to stop, inclusive.
+//
+// Panics if n is negative.
+func Logspace[F is.Float](start, stop F, n int, base F) *SpaceIterator[F] {
+	if n < 0 {
+		panic("Logspace n must not be negative")
+	}
+
+	return &SpaceIterator[F]{start, stop, n, base, 0, n}
+}
+
+// at returns the ith number in the space.
+func (s *SpaceIterator[F]) at(i int) F {
+	var x F
+	switch i {
+	case 0:
+		x = s.start
+	case s.n - 1:
+		x = s.stop
+	default:
+		x = s.start + F(i)*(s.stop-s.start)/F(s.n-1)
+	}
+
+	if s.base == 0 {
+		return x
+	}
+
+	return F(math.Pow(float64(s.base), float64(x)))
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (s *SpaceIterator[F]) Next() *F {
+	next, ok := s.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *SpaceIterator[F]) NextValue() (F, bool) {
+	if s.front >= s.back {
+		return 0, false
+	}
+
+	next := s.at(s.front)
+	s.front += 1
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (s *SpaceIterator[F]) NextBack() *F {
+	if s.front >= s.back {
+		return nil
+	}
+
+	s.back -= 1
+	next := s.at(s.back)
+
+	return &next
+}
+
+// Len returns the number of elements remaining in the iterator.
+func (s *SpaceIterator[F]) Len() int {
+	return s.back - s.front
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *SpaceIterator[F]) SizeHint() (int, int, bool) {
+	n := s.Len()
+	return n, n, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (s *SpaceIterator[F]) Clone() Iterable[F] {
+	c := *s
+	return &c
+}
+
+func (s *SpaceIterator[F]) drain(n int) (int, bool) {
+	n = min(n, s.Len())
+	s.front += n
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *SpaceIterator[F]) Fused() {}
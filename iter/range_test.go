@@ -0,0 +1,193 @@
+package iter_test
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleRange() {
+	fmt.Println(iter.Range(0, 5).Collect())
+	// Output:
+	// [0 1 2 3 4]
+}
+
+func ExampleRangeInclusive() {
+	fmt.Println(iter.RangeInclusive(1, 5).Rev().Collect())
+	// Output:
+	// [5 4 3 2 1]
+}
+
+func ExampleRangeStep() {
+	fmt.Println(iter.RangeStep(0, 10, 3).Collect())
+	fmt.Println(iter.RangeStep(10, 0, -3).Collect())
+	// Output:
+	// [0 3 6 9]
+	// [10 7 4 1]
+}
+
+func ExampleLinspace() {
+	fmt.Println(iter.Linspace(0.0, 1.0, 5).Collect())
+	// Output:
+	// [0 0.25 0.5 0.75 1]
+}
+
+func ExampleLogspace() {
+	fmt.Println(iter.Logspace(0.0, 3.0, 4, 10).Collect())
+	// Output:
+	// [1 10 100 1000]
+}
+
+func TestRange(t *testing.T) {
+	tests := map[string]struct {
+		it   *iter.RangeIterator[int]
+		want []int
+	}{
+		"empty":              {iter.Range(3, 3), nil},
+		"backwards":          {iter.Range(3, 1), nil},
+		"inclusive single":   {iter.RangeInclusive(3, 3), []int{3}},
+		"inclusive empty":    {iter.RangeInclusive(3, 2), nil},
+		"step uneven":        {iter.RangeStep(0, 7, 2), []int{0, 2, 4, 6}},
+		"step even":          {iter.RangeStep(0, 8, 2), []int{0, 2, 4, 6}},
+		"step past end":      {iter.RangeStep(0, 3, 5), []int{0}},
+		"negative step":      {iter.RangeStep(3, -3, -2), []int{3, 1, -1}},
+		"negative step away": {iter.RangeStep(3, 5, -1), nil},
+		"step away":          {iter.RangeStep(5, 3, 1), nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			want := tc.want
+			if n := tc.it.Len(); n != len(want) {
+				t.Errorf("Len \n\thave %v\n\twant %v", n, len(want))
+			}
+
+			have := tc.it.Clone().(*iter.RangeIterator[int]).Collect()
+			if !slices.Equal(have, want) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+			}
+
+			slices.Reverse(want)
+			have = tc.it.Rev().Collect()
+			if !slices.Equal(have, want) {
+				t.Errorf("Rev \n\thave %v\n\twant %v", have, want)
+			}
+		})
+	}
+}
+
+func TestRange_bounds(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		have := iter.RangeInclusive[int8](math.MaxInt8-1, math.MaxInt8).Collect()
+		want := []int8{math.MaxInt8 - 1, math.MaxInt8}
+		if !slices.Equal(have, want) {
+			t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+		}
+
+		if n := iter.RangeInclusive[int8](math.MinInt8, math.MaxInt8).Count(); n != 256 {
+			t.Errorf("Count \n\thave %v\n\twant %v", n, 256)
+		}
+	})
+
+	t.Run("int8 step", func(t *testing.T) {
+		have := iter.RangeStep[int8](math.MaxInt8, math.MinInt8, math.MinInt8).Collect()
+		want := []int8{math.MaxInt8, -1}
+		if !slices.Equal(have, want) {
+			t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+		}
+
+		have = iter.RangeStep[int8](math.MinInt8, math.MaxInt8, 100).Rev().Collect()
+		want = []int8{72, -28, math.MinInt8}
+		if !slices.Equal(have, want) {
+			t.Errorf("Rev \n\thave %v\n\twant %v", have, want)
+		}
+	})
+
+	t.Run("uint8", func(t *testing.T) {
+		r := iter.RangeInclusive[uint8](250, math.MaxUint8)
+		if n := r.Count(); n != 6 {
+			t.Errorf("Count \n\thave %v\n\twant %v", n, 6)
+		}
+		if have := r.Next(); have != nil {
+			t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+		}
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		r := iter.RangeInclusive[uint64](0, math.MaxUint64)
+		if lower, upper, ok := r.SizeHint(); lower != math.MaxInt || upper != 0 || ok {
+			t.Errorf("SizeHint \n\thave %v, %v, %v\n\twant %v, %v, %v", lower, upper, ok, math.MaxInt, 0, false)
+		}
+		if have := *r.NextBack(); have != math.MaxUint64 {
+			t.Errorf("NextBack \n\thave %v\n\twant %v", have, uint64(math.MaxUint64))
+		}
+		if have := *r.Next(); have != 0 {
+			t.Errorf("Next \n\thave %v\n\twant %v", have, 0)
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		r := iter.Range[int64](math.MaxInt64-2, math.MaxInt64)
+		have := r.Collect()
+		want := []int64{math.MaxInt64 - 2, math.MaxInt64 - 1}
+		if !slices.Equal(have, want) {
+			t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+		}
+	})
+}
+
+func TestRangeStep_panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected zero step to panic")
+		}
+	}()
+
+	iter.RangeStep(0, 10, 0)
+}
+
+func TestLinspace(t *testing.T) {
+	tests := map[string]struct {
+		it   *iter.SpaceIterator[float64]
+		want []float64
+	}{
+		"empty":      {iter.Linspace(0.0, 1.0, 0), nil},
+		"single":     {iter.Linspace(2.0, 5.0, 1), []float64{2}},
+		"descending": {iter.Linspace(1.0, -1.0, 3), []float64{1, 0, -1}},
+		"endpoint":   {iter.Linspace(0.0, 0.3, 4), []float64{0, 0.1, 0.2, 0.3}},
+		"log base 2": {iter.Logspace(0.0, 4.0, 3, 2), []float64{1, 4, 16}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			want := tc.want
+			if n := tc.it.Len(); n != len(want) {
+				t.Errorf("Len \n\thave %v\n\twant %v", n, len(want))
+			}
+
+			have := tc.it.Clone().(*iter.SpaceIterator[float64]).Collect()
+			if !approxEqual(have, want) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+			}
+
+			slices.Reverse(want)
+			have = tc.it.Rev().Collect()
+			if !approxEqual(have, want) {
+				t.Errorf("Rev \n\thave %v\n\twant %v", have, want)
+			}
+		})
+	}
+
+	if have := *iter.Linspace(0.0, 0.3, 4).NextBack(); have != 0.3 {
+		t.Errorf("NextBack \n\thave %v\n\twant %v", have, 0.3)
+	}
+}
+
+func approxEqual(a, b []float64) bool {
+	return slices.EqualFunc(a, b, func(x, y float64) bool {
+		return math.Abs(x-y) < 1e-9
+	})
+}
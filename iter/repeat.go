@@ -0,0 +1,147 @@
+package iter
+
+import "math"
+
+// Repeated is an Iterable that yields the same element over and over.
+type Repeated[T any] struct {
+	val T
+	// n is the number of repetitions remaining, or -1 if there is no limit.
+	n int
+}
+
+// Repeat creates an iterator that endlessly repeats a single element.
+func Repeat[T any](val T) *Repeated[T] {
+	return &Repeated[T]{val, -1}
+}
+
+// RepeatN creates an iterator that repeats a single element n times.
+//
+// Panics if n is negative.
+func RepeatN[T any](val T, n int) *Repeated[T] {
+	if n < 0 {
+		panic("RepeatN n must not be negative")
+	}
+
+	return &Repeated[T]{val, n}
+}
+
+// Once creates an iterator that yields an element exactly once.
+func Once[T any](val T) *Repeated[T] {
+	return RepeatN(val, 1)
+}
+
+// Empty creates an iterator that yields nothing.
+func Empty[T any]() *Repeated[T] {
+	var zero T
+	return RepeatN(zero, 0)
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Each call returns a new copy of the repeated element. Returns nil when
+// iteration is finished.
+func (r *Repeated[T]) Next() *T {
+	next, ok := r.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (r *Repeated[T]) NextValue() (T, bool) {
+	if r.n == 0 {
+		var zero T
+		return zero, false
+	}
+
+	if r.n > 0 {
+		r.n -= 1
+	}
+
+	return r.val, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (r *Repeated[T]) NextBack() *T {
+	return r.Next()
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (r *Repeated[T]) SizeHint() (int, int, bool) {
+	if r.n < 0 {
+		return math.MaxInt, 0, false
+	}
+
+	return r.n, r.n, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (r *Repeated[T]) Clone() Iterable[T] {
+	c := *r
+	return &c
+}
+
+func (r *Repeated[T]) drain(n int) (int, bool) {
+	if r.n >= 0 {
+		n = min(n, r.n)
+		r.n -= n
+	}
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *Repeated[T]) Fused() {}
+
+// RepeatedWith is an Iterable that endlessly yields the results of a function.
+type RepeatedWith[T any] struct {
+	fn func() T
+}
+
+// RepeatWith creates an iterator that endlessly yields the results of calling
+// fn.
+//
+// Unlike Repeat, the element is produced afresh on each call, which suits
+// values that are expensive to copy or that should not share state.
+func RepeatWith[T any](fn func() T) *RepeatedWith[T] {
+	return &RepeatedWith[T]{fn}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Never returns nil.
+func (r *RepeatedWith[T]) Next() *T {
+	next := r.fn()
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Always returns true.
+func (r *RepeatedWith[T]) NextValue() (T, bool) {
+	return r.fn(), true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (r *RepeatedWith[T]) SizeHint() (int, int, bool) {
+	return math.MaxInt, 0, false
+}
+
+// Clone returns an independent copy of the iterator.
+//
+// The function is shared between the clones.
+func (r *RepeatedWith[T]) Clone() Iterable[T] {
+	return &RepeatedWith[T]{r.fn}
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *RepeatedWith[T]) Fused() {}
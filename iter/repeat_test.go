@@ -0,0 +1,86 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleRepeat() {
+	fmt.Println(iter.Repeat("a").Take(3).Collect())
+	// Output:
+	// [a a a]
+}
+
+func ExampleRepeatN() {
+	fmt.Println(iter.RepeatN(7, 2).Collect())
+	// Output:
+	// [7 7]
+}
+
+func ExampleOnce() {
+	i := iter.Chain[int](iter.New([]int{1, 2}), iter.Once(3))
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleEmpty() {
+	fmt.Println(iter.Empty[int]().Next())
+	// Output:
+	// <nil>
+}
+
+func ExampleRepeatWith() {
+	n := 1
+	double := func() int {
+		n *= 2
+		return n
+	}
+
+	fmt.Println(iter.RepeatWith(double).Take(4).Collect())
+	// Output:
+	// [2 4 8 16]
+}
+
+func TestRepeatN(t *testing.T) {
+	r := iter.RepeatN(1, 3)
+
+	if lower, upper, ok := r.SizeHint(); lower != 3 || upper != 3 || !ok {
+		t.Errorf("SizeHint \n\thave %v %v %v\n\twant 3 3 true", lower, upper, ok)
+	}
+
+	r.Next()
+	if n := r.Count(); n != 2 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 2)
+	}
+	if have := r.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+}
+
+func TestRepeat_copy(t *testing.T) {
+	r := iter.Repeat(1)
+
+	*r.Next() = 2
+	if have := *r.Next(); have != 1 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 1)
+	}
+}
+
+func TestRepeat_unbounded(t *testing.T) {
+	have := iter.Repeat(1).StepBy(2).Take(3).Collect()
+	if want := []int{1, 1, 1}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+
+	if _, _, ok := iter.Repeat(1).SizeHint(); ok {
+		t.Errorf("SizeHint \n\thave an upper bound\n\twant none")
+	}
+	if n := iter.Repeat(1).Take(1 << 40).Count(); n != 1<<40 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 1<<40)
+	}
+}
@@ -0,0 +1,71 @@
+package iter
+
+// Reversed is an Iterable over a DoubleEndedIterable with the direction
+// reversed.
+type Reversed[T any] struct {
+	iter DoubleEndedIterable[T]
+}
+
+// Rev reverses an iterator's direction.
+//
+// Usually, iterators iterate from left to right. After using Rev, an iterator
+// will instead iterate from right to left.
+//
+// Panics if the iterator is not double ended; see IsDoubleEnded.
+func Rev[T any](iter DoubleEndedIterable[T]) *Reversed[T] {
+	checkBack[T](iter, "Rev")
+
+	return &Reversed[T]{iter}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (r *Reversed[T]) Next() *T {
+	return r.iter.NextBack()
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (r *Reversed[T]) NextValue() (T, bool) {
+	next := r.iter.NextBack()
+	if next == nil {
+		var zero T
+		return zero, false
+	}
+
+	return *next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (r *Reversed[T]) NextBack() *T {
+	return r.iter.Next()
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (r *Reversed[T]) SizeHint() (int, int, bool) {
+	return SizeHint[T](r.iter)
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (r *Reversed[T]) Stop() {
+	stop[T](r.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (r *Reversed[T]) Clone() Iterable[T] {
+	return &Reversed[T]{back(clone(r.iter))}
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (r *Reversed[T]) Cloneable() bool {
+	return IsCloneable[T](r.iter)
+}
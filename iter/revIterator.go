@@ -19,4 +19,63 @@ func (iter *RevIterator[T]) Next() *T {
 	return next
 }
 
-//go:generate go run ./cmd/gen/ -name RevIterator -output revIterator_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (iter *RevIterator[T]) NextValue() (T, bool) {
+	if iter.it.idx < 0 {
+		var zero T
+		return zero, false
+	}
+
+	next := iter.it.slice[iter.it.idx]
+	iter.it.idx -= 1
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (iter *RevIterator[T]) NextBack() *T {
+	if iter.it.idx < 0 {
+		return nil
+	}
+
+	next := &iter.it.slice[0]
+	iter.it.slice = iter.it.slice[1:]
+	iter.it.idx -= 1
+
+	return next
+}
+
+// Len returns the number of elements remaining in the iterator.
+func (iter *RevIterator[T]) Len() int {
+	return iter.it.idx + 1
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (iter *RevIterator[T]) SizeHint() (int, int, bool) {
+	n := iter.Len()
+	return n, n, true
+}
+
+func (iter *RevIterator[T]) drain(n int) (int, bool) {
+	n = min(n, iter.Len())
+	iter.it.idx -= n
+
+	return n, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The underlying slice is shared between the clones.
+func (iter *RevIterator[T]) Clone() Iterable[T] {
+	c := *iter
+	return &c
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (iter *RevIterator[T]) Fused() {}
@@ -2,6 +2,8 @@
 
 package iter
 
+import stditer "iter"
+
 // Find searches for an element of an iterator that satisfies a predicate.
 //
 // Takes a function that returns true or false. It applies this function to
@@ -10,7 +12,8 @@ package iter
 // nil.
 //
 // Find is short-circuiting; in other words, it will stop processing as soon as
-// the predicate returns true.
+// the predicate returns true. The iterator is then stopped if it is a Stopper,
+// as the rest of its elements are abandoned.
 func (iter *RevIterator[T]) Find(pred func(T) bool) *T {
 	return Find[T](iter, pred)
 }
@@ -79,6 +82,22 @@ func (iter *RevIterator[T]) Take(n int) *Taken[T] {
 	return Take[T](iter, n)
 }
 
+// Peekable creates an iterator which can use the Peek and PeekMut methods to
+// look at the next element of the iterator without consuming it.
+func (iter *RevIterator[T]) Peekable() *PeekableT[T] {
+	return Peekable[T](iter)
+}
+
+// Fuse creates an iterator which ends after the first nil.
+//
+// After an iterator returns nil, future calls may or may not yield further
+// elements. Fuse adapts an iterator, ensuring that after a nil is given, it
+// will always return nil forever, and the underlying iterator is not polled
+// again.
+func (iter *RevIterator[T]) Fuse() *Fused[T] {
+	return Fuse[T](iter)
+}
+
 // Collect transforms an iterator into a slice.
 func (iter *RevIterator[T]) Collect() []T {
 	return Collect[T](iter)
@@ -108,6 +127,15 @@ func (iter *RevIterator[T]) Nth(n int) *T {
 	return Nth[T](iter, n)
 }
 
+// Position searches for an element in an iterator, returning its index.
+//
+// Position is short-circuiting; it will stop processing as soon as the
+// predicate returns true, and stop the iterator if it is a Stopper. It returns
+// -1 if no element satisfies the predicate.
+func (iter *RevIterator[T]) Position(pred func(T) bool) int {
+	return Position[T](iter, pred)
+}
+
 // All tests if every element of the iterator matches a predicate.
 //
 // All takes a function that returns true or false. It applies this function to
@@ -116,7 +144,7 @@ func (iter *RevIterator[T]) Nth(n int) *T {
 //
 // All is short-circuiting; in other words, it will stop processing as soon as
 // it finds a false, given that no matter what else happens, the result will
-// also be false.
+// also be false. The iterator is then stopped if it is a Stopper.
 //
 // An empty iterator returns true.
 func (iter *RevIterator[T]) All(pred func(T) bool) bool {
@@ -131,7 +159,7 @@ func (iter *RevIterator[T]) All(pred func(T) bool) bool {
 //
 // Any is short-circuiting; in other words, it will stop processing as soon as
 // it finds a true, given that no matter what else happens, the result will also
-// be true.
+// be true. The iterator is then stopped if it is a Stopper.
 //
 // An empty iterator returns false.
 func (iter *RevIterator[T]) Any(pred func(T) bool) bool {
@@ -146,3 +174,50 @@ func (iter *RevIterator[T]) Any(pred func(T) bool) bool {
 func (iter *RevIterator[T]) Last() *T {
 	return Last[T](iter)
 }
+
+// Seq returns a sequence over the remaining elements of the iterator, for use
+// with range-over-func loops and the standard library.
+func (iter *RevIterator[T]) Seq() stditer.Seq[T] {
+	return Seq[T](iter)
+}
+
+// Seq2 returns a sequence of index-value pairs over the remaining elements of
+// the iterator, for use with range-over-func loops and the standard library.
+func (iter *RevIterator[T]) Seq2() stditer.Seq2[int, T] {
+	return Seq2[T](iter)
+}
+
+// Rev reverses an iterator's direction.
+//
+// Usually, iterators iterate from left to right. After using Rev, an iterator
+// will instead iterate from right to left.
+func (iter *RevIterator[T]) Rev() *Reversed[T] {
+	return Rev[T](iter)
+}
+
+// RFind searches for an element of an iterator from the back that satisfies a
+// predicate.
+//
+// RFind is the reverse version of Find. It is short-circuiting, and returns
+// nil if no element satisfies the predicate.
+func (iter *RevIterator[T]) RFind(pred func(T) bool) *T {
+	return RFind[T](iter, pred)
+}
+
+// RPosition searches for an element in an iterator from the back, returning
+// its index counted from the front.
+//
+// RPosition is short-circuiting; it will stop processing as soon as the
+// predicate returns true. It returns -1 if no element satisfies the predicate.
+func (iter *RevIterator[T]) RPosition(pred func(T) bool) int {
+	return RPosition[T](iter, pred)
+}
+
+// Cycle repeats an iterator endlessly.
+//
+// Instead of stopping when the iterator is exhausted, Cycle will start again,
+// from a clone of the iterator as it was when Cycle was called. If the
+// iterator is empty, so is the cycle.
+func (iter *RevIterator[T]) Cycle() *Cycled[T] {
+	return Cycle[T](iter)
+}
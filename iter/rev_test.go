@@ -0,0 +1,92 @@
+package iter_test
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleRev() {
+	double := func(n int) int { return n * 2 }
+	m := iter.Map[int, int](iter.New([]int{1, 2, 3}), double)
+
+	fmt.Println(iter.Rev[int](m).Collect())
+	// Output:
+	// [6 4 2]
+}
+
+func ExampleRev_list() {
+	l := list.New()
+	for i := 1; i <= 4; i++ {
+		l.PushBack(i)
+	}
+
+	fmt.Println(iter.Rev[int](iter.FromList[int](l)).Take(2).Collect())
+	// Output:
+	// [4 3]
+}
+
+func ExampleIsDoubleEnded() {
+	isEven := func(n int) bool { return n%2 == 0 }
+	evens := iter.New([]int{1, 2, 3, 4}).Filter(isEven)
+	seq := iter.FromSeq(slices.Values([]int{1, 2, 3, 4})).Filter(isEven)
+
+	fmt.Println(iter.IsDoubleEnded[int](evens), iter.IsDoubleEnded[int](seq))
+	seq.Stop()
+	fmt.Println(iter.Rev[int](evens).Collect())
+	// Output:
+	// true false
+	// [4 2]
+}
+
+func ExampleRFind() {
+	isEven := func(n int) bool { return n%2 == 0 }
+	i := iter.New([]int{1, 2, 3, 4, 5})
+
+	fmt.Println(*iter.RFind[int](i, isEven))
+	fmt.Println(*iter.RFind[int](i, isEven))
+	fmt.Println(iter.RFind[int](i, isEven))
+	// Output:
+	// 4
+	// 2
+	// <nil>
+}
+
+func ExampleRFold() {
+	concat := func(s string, n int) string { return s + fmt.Sprint(n) }
+	i := iter.New([]int{1, 2, 3})
+
+	fmt.Println(iter.RFold[int](i, "", concat))
+	// Output:
+	// 321
+}
+
+func ExampleRPosition() {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	fmt.Println(iter.RPosition[int](iter.New([]int{1, 2, 3, 4, 5}), isEven))
+	fmt.Println(iter.RPosition[int](iter.New([]int{1, 3, 5}), isEven))
+	// Output:
+	// 3
+	// -1
+}
+
+func ExamplePosition() {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	fmt.Println(iter.New([]int{1, 3, 4, 5}).Position(isEven))
+	fmt.Println(iter.New([]int{1, 3, 5}).Position(isEven))
+	// Output:
+	// 2
+	// -1
+}
+
+func ExampleReversed_Collect() {
+	i := iter.Rev[int](iter.New([]int{1, 2, 3, 4}).Skip(1))
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [4 3 2]
+}
@@ -0,0 +1,109 @@
+package iter
+
+import "container/ring"
+
+// RingIterator is a lazy iterator over a container/ring
+type RingIterator[T any] struct {
+	front, back *ring.Ring
+	n           int
+}
+
+// FromRing creates a new lazy iterator over the provided container/ring,
+// visiting each element exactly once, starting at r.
+//
+// A nil ring is empty. Counting the elements of the ring takes time
+// proportional to its length.
+func FromRing[T any](r *ring.Ring) *RingIterator[T] {
+	if r == nil {
+		return &RingIterator[T]{}
+	}
+
+	return &RingIterator[T]{r, r.Prev(), r.Len()}
+}
+
+// CycleRing creates an iterator that walks the provided container/ring
+// endlessly, starting at r.
+//
+// If the ring is nil, so is the cycle.
+func CycleRing[T any](r *ring.Ring) *Cycled[T] {
+	return Cycle[T](FromRing[T](r))
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if the next value does not conform
+// to the specified type.
+func (it *RingIterator[T]) Next() *T {
+	next, ok := it.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (it *RingIterator[T]) NextValue() (T, bool) {
+	if it.n == 0 {
+		var zero T
+		return zero, false
+	}
+
+	el, ok := it.front.Value.(T)
+	if !ok {
+		return el, false
+	}
+
+	it.front = it.front.Next()
+	it.n -= 1
+
+	return el, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished, or if the value does not conform to
+// the specified type.
+func (it *RingIterator[T]) NextBack() *T {
+	if it.n == 0 {
+		return nil
+	}
+
+	el, ok := it.back.Value.(T)
+	if !ok {
+		return nil
+	}
+
+	it.back = it.back.Prev()
+	it.n -= 1
+
+	return &el
+}
+
+// Len returns the number of elements remaining in the iterator.
+//
+// Elements are counted regardless of whether they conform to the specified
+// type.
+func (it *RingIterator[T]) Len() int {
+	return it.n
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (it *RingIterator[T]) SizeHint() (int, int, bool) {
+	return it.n, it.n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (it *RingIterator[T]) Fused() {}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The underlying ring is shared between the clones.
+func (it *RingIterator[T]) Clone() Iterable[T] {
+	c := *it
+	return &c
+}
@@ -0,0 +1,71 @@
+package iter_test
+
+import (
+	"container/ring"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func makeRing(vals ...any) *ring.Ring {
+	r := ring.New(len(vals))
+	for _, v := range vals {
+		r.Value = v
+		r = r.Next()
+	}
+
+	return r
+}
+
+func ExampleFromRing() {
+	r := makeRing(1, 2, 3, 4)
+
+	fmt.Println(iter.FromRing[int](r.Next()).Collect())
+	// Output:
+	// [2 3 4 1]
+}
+
+func ExampleCycleRing() {
+	r := makeRing("a", "b")
+
+	fmt.Println(iter.CycleRing[string](r).Take(5).Collect())
+	// Output:
+	// [a b a b a]
+}
+
+func TestFromRing(t *testing.T) {
+	r := makeRing(1, 2, 3)
+	i := iter.FromRing[int](r)
+
+	if n := i.Len(); n != 3 {
+		t.Errorf("Len \n\thave %v\n\twant %v", n, 3)
+	}
+	if have := *i.NextBack(); have != 3 {
+		t.Errorf("NextBack \n\thave %v\n\twant %v", have, 3)
+	}
+	if have, want := i.Collect(), []int{1, 2}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+	if have := i.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+}
+
+func TestFromRing_empty(t *testing.T) {
+	if have := iter.FromRing[int](nil).Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if have := iter.CycleRing[int](nil).Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+}
+
+func TestFromRing_type(t *testing.T) {
+	have := iter.FromRing[int](makeRing(1, "two", 3)).Collect()
+
+	if want := []int{1}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
@@ -0,0 +1,193 @@
+package iter
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// RowsIterator is an Iterable over the rows of a database/sql query result,
+// which ends at the first error.
+type RowsIterator[T any] struct {
+	rows *sql.Rows
+	scan func(*sql.Rows) (T, error)
+	err  error
+	done bool
+}
+
+// FromRows creates an iterator over the rows of a query result, converting
+// each with scan.
+//
+// The rows are closed once iteration is finished, an error occurs, or Stop is
+// called. Callers abandoning iteration early should call Stop, or Close, to
+// release the connection.
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) *RowsIterator[T] {
+	return &RowsIterator[T]{rows, scan, nil, false}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if reading or scanning a row
+// failed.
+func (r *RowsIterator[T]) Next() *T {
+	next, ok := r.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished, or if reading
+// or scanning a row failed.
+func (r *RowsIterator[T]) NextValue() (T, bool) {
+	var zero T
+	if r.done {
+		return zero, false
+	}
+
+	if !r.rows.Next() {
+		r.err = r.rows.Err()
+		r.Close()
+		return zero, false
+	}
+
+	next, err := r.scan(r.rows)
+	if err != nil {
+		r.err = err
+		r.Close()
+		return zero, false
+	}
+
+	return next, true
+}
+
+// Err returns the first error encountered while reading, scanning or closing
+// the rows, if any.
+func (r *RowsIterator[T]) Err() error {
+	return r.err
+}
+
+// Close ends iteration and closes the rows, returning any error from closing
+// them. It is safe to call Close more than once.
+func (r *RowsIterator[T]) Close() error {
+	if r.done {
+		return nil
+	}
+
+	r.done = true
+	err := r.rows.Close()
+	if r.err == nil {
+		r.err = err
+	}
+
+	return err
+}
+
+// Stop ends iteration and closes the rows. Any error from closing them is
+// reported by Err.
+func (r *RowsIterator[T]) Stop() {
+	r.Close()
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *RowsIterator[T]) Fused() {}
+
+// structFields caches the field indices of struct types for a set of columns.
+var structFields sync.Map
+
+// structKey identifies a struct type scanned from a set of columns.
+type structKey struct {
+	typ  reflect.Type
+	cols string
+}
+
+// ScanStruct scans the current row into a new struct of type T, for use with
+// FromRows.
+//
+// Each column is stored in the field tagged with its name, eg `db:"user_id"`.
+// Exported fields without a tag match columns with the same name, ignoring
+// case, and fields tagged `db:"-"` are ignored. Fields of embedded structs are
+// included, unless embedded by pointer. Returns an error if T is not a struct,
+// or a column has no matching field.
+func ScanStruct[T any](rows *sql.Rows) (T, error) {
+	var next T
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return next, err
+	}
+
+	typ := reflect.TypeOf(next)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return next, fmt.Errorf("iter: ScanStruct requires a struct, not %v", typ)
+	}
+
+	key := structKey{typ, strings.Join(cols, "\x00")}
+	fields, ok := structFields.Load(key)
+	if !ok {
+		fields, err = fieldIndices(typ, cols)
+		if err != nil {
+			return next, err
+		}
+		structFields.Store(key, fields)
+	}
+
+	v := reflect.ValueOf(&next).Elem()
+	dest := make([]any, len(cols))
+	for i, index := range fields.([][]int) {
+		dest[i] = v.FieldByIndex(index).Addr().Interface()
+	}
+
+	return next, rows.Scan(dest...)
+}
+
+// fieldIndices returns the index of the struct field for each column.
+func fieldIndices(typ reflect.Type, cols []string) ([][]int, error) {
+	tagged := map[string][]int{}
+	named := map[string][]int{}
+
+fields:
+	for _, f := range reflect.VisibleFields(typ) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+
+		// skip fields promoted through embedded pointers, which may be nil
+		t := typ
+		for _, i := range f.Index[:len(f.Index)-1] {
+			t = t.Field(i).Type
+			if t.Kind() == reflect.Pointer {
+				continue fields
+			}
+		}
+
+		switch tag := f.Tag.Get("db"); tag {
+		case "-":
+		case "":
+			named[strings.ToLower(f.Name)] = f.Index
+		default:
+			tagged[tag] = f.Index
+		}
+	}
+
+	indices := make([][]int, len(cols))
+	for i, col := range cols {
+		index, ok := tagged[col]
+		if !ok {
+			index, ok = named[strings.ToLower(col)]
+		}
+		if !ok {
+			return nil, fmt.Errorf("iter: no field of %v for column %q", typ, col)
+		}
+
+		indices[i] = index
+	}
+
+	return indices, nil
+}
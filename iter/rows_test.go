@@ -0,0 +1,265 @@
+package iter_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+// fakeTable is an in-process database/sql driver serving a single result set
+// for any query.
+type fakeTable struct {
+	cols []string
+	rows [][]driver.Value
+	// failAt is the index of a row that fails to be read, or -1.
+	failAt int
+	// closed counts the result sets closed.
+	closed int
+}
+
+var errFakeRow = errors.New("fake row failed")
+
+func (t *fakeTable) Connect(context.Context) (driver.Conn, error) { return fakeConn{t}, nil }
+func (t *fakeTable) Driver() driver.Driver                        { return nil }
+
+func (t *fakeTable) open(tb testing.TB) *sql.Rows {
+	db := sql.OpenDB(t)
+	tb.Cleanup(func() { db.Close() })
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return rows
+}
+
+type fakeConn struct{ t *fakeTable }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt(c), nil }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+type fakeStmt struct{ t *fakeTable }
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return 0 }
+func (s fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{s.t, 0}, nil }
+
+type fakeRows struct {
+	t *fakeTable
+	i int
+}
+
+func (r *fakeRows) Columns() []string { return r.t.cols }
+
+func (r *fakeRows) Close() error {
+	r.t.closed += 1
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i == r.t.failAt {
+		return errFakeRow
+	}
+	if r.i >= len(r.t.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.t.rows[r.i])
+	r.i += 1
+
+	return nil
+}
+
+func newUsers() *fakeTable {
+	return &fakeTable{
+		cols: []string{"id", "user_name", "Email"},
+		rows: [][]driver.Value{
+			{int64(1), "ann", "ann@example.com"},
+			{int64(2), "bob", "bob@example.com"},
+			{int64(3), "cat", "cat@example.com"},
+		},
+		failAt: -1,
+	}
+}
+
+type user struct {
+	ID    int64  `db:"id"`
+	Name  string `db:"user_name"`
+	Email string
+	Notes string `db:"-"`
+}
+
+func ExampleFromRows() {
+	table := newUsers()
+	db := sql.OpenDB(table)
+	defer db.Close()
+
+	rows, _ := db.Query("SELECT id FROM users")
+	scanID := func(rows *sql.Rows) (int64, error) {
+		var id int64
+		err := rows.Scan(&id, new(string), new(string))
+		return id, err
+	}
+
+	ids := iter.FromRows(rows, scanID)
+	fmt.Println(ids.Collect(), ids.Err())
+	// Output:
+	// [1 2 3] <nil>
+}
+
+func ExampleScanStruct() {
+	table := newUsers()
+	db := sql.OpenDB(table)
+	defer db.Close()
+
+	rows, _ := db.Query("SELECT * FROM users")
+	users := iter.FromRows(rows, iter.ScanStruct[user])
+
+	fmt.Println(*users.Find(func(u user) bool { return u.Name == "bob" }))
+	users.Stop()
+	// Output:
+	// {2 bob bob@example.com }
+}
+
+func TestFromRows_close(t *testing.T) {
+	t.Run("exhausted", func(t *testing.T) {
+		table := newUsers()
+		users := iter.FromRows(table.open(t), iter.ScanStruct[user])
+
+		if n := users.Count(); n != 3 {
+			t.Errorf("Count \n\thave %v\n\twant %v", n, 3)
+		}
+		if table.closed != 1 {
+			t.Errorf("rows closed %v times, want %v", table.closed, 1)
+		}
+	})
+
+	t.Run("taken", func(t *testing.T) {
+		table := newUsers()
+		users := iter.FromRows(table.open(t), iter.ScanStruct[user])
+
+		// the loop runs to completion, so only Take ending closes the rows
+		for range users.Take(1).Seq() {
+		}
+		if table.closed != 1 {
+			t.Errorf("rows closed %v times, want %v", table.closed, 1)
+		}
+	})
+
+	t.Run("found", func(t *testing.T) {
+		table := newUsers()
+		users := iter.FromRows(table.open(t), iter.ScanStruct[user])
+
+		if u := users.Find(func(u user) bool { return u.ID == 1 }); u == nil {
+			t.Errorf("Find \n\thave <nil>\n\twant user 1")
+		}
+		if table.closed != 1 {
+			t.Errorf("rows closed %v times, want %v", table.closed, 1)
+		}
+	})
+
+	t.Run("abandoned", func(t *testing.T) {
+		table := newUsers()
+		users := iter.FromRows(table.open(t), iter.ScanStruct[user])
+
+		for u := range users.Seq() {
+			if u.ID == 1 {
+				break
+			}
+		}
+		if table.closed != 1 {
+			t.Errorf("rows closed %v times, want %v", table.closed, 1)
+		}
+
+		users.Stop()
+		if err := users.Close(); err != nil {
+			t.Errorf("Close \n\thave %v\n\twant <nil>", err)
+		}
+		if next := users.Next(); next != nil {
+			t.Errorf("Next \n\thave %v\n\twant <nil>", *next)
+		}
+	})
+}
+
+func TestFromRows_err(t *testing.T) {
+	table := newUsers()
+	table.failAt = 2
+	users := iter.FromRows(table.open(t), iter.ScanStruct[user])
+
+	have := iter.Map(users, func(u user) string { return u.Name }).Collect()
+	if want := []string{"ann", "bob"}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+	if err := users.Err(); !errors.Is(err, errFakeRow) {
+		t.Errorf("Err \n\thave %v\n\twant %v", err, errFakeRow)
+	}
+	if table.closed != 1 {
+		t.Errorf("rows closed %v times, want %v", table.closed, 1)
+	}
+}
+
+func TestScanStruct(t *testing.T) {
+	type Base struct {
+		ID int64 `db:"id"`
+	}
+	type embedded struct {
+		Base
+		Name  string `db:"user_name"`
+		EMAIL string
+	}
+
+	table := newUsers()
+	have := iter.FromRows(table.open(t), iter.ScanStruct[embedded]).Collect()
+	if len(have) != 3 || have[2].ID != 3 || have[2].Name != "cat" || have[2].EMAIL != "cat@example.com" {
+		t.Errorf("Collect \n\thave %+v\n\twant 3 users ending with cat", have)
+	}
+}
+
+func TestScanStruct_err(t *testing.T) {
+	type missing struct {
+		ID   int64  `db:"id"`
+		Name string `db:"user_name"`
+	}
+
+	tests := map[string]func(*sql.Rows) *iter.RowsIterator[any]{
+		"missing field": func(rows *sql.Rows) *iter.RowsIterator[any] {
+			return iter.FromRows(rows, func(rows *sql.Rows) (any, error) {
+				return iter.ScanStruct[missing](rows)
+			})
+		},
+		"not a struct": func(rows *sql.Rows) *iter.RowsIterator[any] {
+			return iter.FromRows(rows, func(rows *sql.Rows) (any, error) {
+				return iter.ScanStruct[int](rows)
+			})
+		},
+	}
+
+	for name, open := range tests {
+		t.Run(name, func(t *testing.T) {
+			table := newUsers()
+			rows := open(table.open(t))
+
+			if next := rows.Next(); next != nil {
+				t.Errorf("Next \n\thave %v\n\twant <nil>", *next)
+			}
+			if rows.Err() == nil {
+				t.Errorf("Err \n\thave <nil>\n\twant an error")
+			}
+			if table.closed != 1 {
+				t.Errorf("rows closed %v times, want %v", table.closed, 1)
+			}
+		})
+	}
+}
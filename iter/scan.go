@@ -0,0 +1,115 @@
+package iter
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Scanned is an Iterable over the tokens read from an io.Reader by a
+// bufio.Scanner, which ends at the first read error.
+type Scanned[T any] struct {
+	scanner *bufio.Scanner
+	conv    func([]byte) T
+	done    bool
+}
+
+func newScanned[T any](r io.Reader, split bufio.SplitFunc, conv func([]byte) T) *Scanned[T] {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(split)
+
+	return &Scanned[T]{scanner, conv, false}
+}
+
+// Lines creates an iterator over the lines read from r.
+//
+// Lines are yielded without their line ending, which may be "\n" or "\r\n".
+// The final line need not have a line ending. Lines are limited to
+// bufio.MaxScanTokenSize bytes unless MaxTokenSize is called.
+func Lines(r io.Reader) *Scanned[string] {
+	return newScanned(r, bufio.ScanLines, bytesToString)
+}
+
+// SplitWith creates an iterator over the tokens read from r by split, such as
+// bufio.ScanWords.
+//
+// Each token is a newly allocated copy, which remains valid after further
+// iteration.
+func SplitWith(r io.Reader, split bufio.SplitFunc) *Scanned[[]byte] {
+	return newScanned(r, split, bytes.Clone)
+}
+
+// Records creates an iterator over the records read from r, separated by delim.
+//
+// Records are yielded without the delimiter. The final record need not be
+// followed by a delimiter; an empty final record is not yielded.
+func Records(r io.Reader, delim byte) *Scanned[string] {
+	return newScanned(r, scanDelim(delim), bytesToString)
+}
+
+func bytesToString(b []byte) string {
+	return string(b)
+}
+
+// scanDelim returns a bufio.SplitFunc splitting on delim.
+func scanDelim(delim byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+
+		// request more data
+		return 0, nil, nil
+	}
+}
+
+// MaxTokenSize sets the maximum size of a token, and returns the iterator.
+//
+// Reading a longer token ends iteration with bufio.ErrTooLong. Panics if called
+// after iteration has started.
+func (s *Scanned[T]) MaxTokenSize(n int) *Scanned[T] {
+	s.scanner.Buffer(nil, n)
+	return s
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if reading failed.
+func (s *Scanned[T]) Next() *T {
+	next, ok := s.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished, or if reading
+// failed.
+func (s *Scanned[T]) NextValue() (T, bool) {
+	if s.done || !s.scanner.Scan() {
+		s.done = true
+		var zero T
+		return zero, false
+	}
+
+	return s.conv(s.scanner.Bytes()), true
+}
+
+// Err returns the first error encountered while reading, if any. Reaching the
+// end of the input is not an error.
+func (s *Scanned[T]) Err() error {
+	return s.scanner.Err()
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *Scanned[T]) Fused() {}
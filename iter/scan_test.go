@@ -0,0 +1,107 @@
+package iter_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleLines() {
+	r := strings.NewReader("# header\r\nfirst\r\nsecond\nthird")
+	notComment := func(s string) bool { return !strings.HasPrefix(s, "#") }
+
+	fmt.Printf("%q\n", iter.Lines(r).Filter(notComment).Take(2).Collect())
+	// Output:
+	// ["first" "second"]
+}
+
+func ExampleSplitWith() {
+	r := strings.NewReader("one two  three")
+	words := iter.Map(iter.SplitWith(r, bufio.ScanWords), func(b []byte) string {
+		return strings.ToUpper(string(b))
+	})
+
+	fmt.Println(words.Collect())
+	// Output:
+	// [ONE TWO THREE]
+}
+
+func ExampleRecords() {
+	r := strings.NewReader("a,b,,c,")
+
+	fmt.Printf("%q\n", iter.Records(r, ',').Collect())
+	// Output:
+	// ["a" "b" "" "c"]
+}
+
+func TestLines(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  []string
+	}{
+		"empty":         {"", nil},
+		"no line end":   {"a", []string{"a"}},
+		"trailing LF":   {"a\n", []string{"a"}},
+		"CRLF":          {"a\r\nb\r\n", []string{"a", "b"}},
+		"blank lines":   {"\n\na", []string{"", "", "a"}},
+		"mixed endings": {"a\nb\r\nc", []string{"a", "b", "c"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lines := iter.Lines(strings.NewReader(tc.input))
+
+			have := lines.Collect()
+			if !slices.Equal(have, tc.want) {
+				t.Errorf("Collect \n\thave %q\n\twant %q", have, tc.want)
+			}
+			if err := lines.Err(); err != nil {
+				t.Errorf("Err \n\thave %v\n\twant <nil>", err)
+			}
+		})
+	}
+}
+
+func TestLines_err(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead))
+	lines := iter.Lines(r)
+
+	have := lines.Collect()
+	if want := []string{"a", "b"}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %q\n\twant %q", have, want)
+	}
+	if err := lines.Err(); !errors.Is(err, errRead) {
+		t.Errorf("Err \n\thave %v\n\twant %v", err, errRead)
+	}
+	if next := lines.Next(); next != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *next)
+	}
+}
+
+func TestScanned_MaxTokenSize(t *testing.T) {
+	r := strings.NewReader("short\n" + strings.Repeat("x", 64) + "\nshort\n")
+	lines := iter.Lines(r).MaxTokenSize(32)
+
+	if n := lines.Count(); n != 1 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 1)
+	}
+	if err := lines.Err(); !errors.Is(err, bufio.ErrTooLong) {
+		t.Errorf("Err \n\thave %v\n\twant %v", err, bufio.ErrTooLong)
+	}
+}
+
+func TestSplitWith_copy(t *testing.T) {
+	words := iter.SplitWith(strings.NewReader("ab cd"), bufio.ScanWords).Collect()
+
+	if len(words) != 2 || string(words[0]) != "ab" || string(words[1]) != "cd" {
+		t.Errorf("Collect \n\thave %q\n\twant %q", words, []string{"ab", "cd"})
+	}
+}
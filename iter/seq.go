@@ -0,0 +1,136 @@
+package iter
+
+import stditer "iter"
+
+// Stopper is implemented by iterators holding resources that must be released
+// if iteration is abandoned before the iterator is exhausted.
+//
+// The adapters in this package are Stoppers, stopping the iterators they adapt.
+// Adapters that end before the iterators they adapt, such as Take and
+// TakeWhile, also stop them when they do.
+type Stopper interface {
+	// Stop releases the resources held by the iterator. Subsequent calls to
+	// Next return nil.
+	//
+	// It is safe to call Stop more than once.
+	Stop()
+}
+
+// stop calls Stop on iter, if it is a Stopper.
+func stop[T any](iter Iterable[T]) {
+	if s, ok := iter.(Stopper); ok {
+		s.Stop()
+	}
+}
+
+// Seq returns a sequence over the remaining elements of an iterator, for use
+// with range-over-func loops and the standard library.
+//
+// Ranging over the sequence consumes the iterator. Breaking out of the loop
+// early stops the iterator if it is a Stopper; otherwise the remaining elements
+// may still be obtained by calling Next.
+func Seq[T any](iter Iterable[T]) stditer.Seq[T] {
+	return func(yield func(T) bool) {
+		pull := valueFunc(iter)
+		for next, ok := pull(); ok; next, ok = pull() {
+			if !yield(next) {
+				stop(iter)
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns a sequence of index-value pairs over the remaining elements of
+// an iterator, for use with range-over-func loops and the standard library.
+//
+// Indices count from zero, starting at the first element yielded by the
+// sequence. Early exit behaves as described for Seq.
+func Seq2[T any](iter Iterable[T]) stditer.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := 0
+		pull := valueFunc(iter)
+		for next, ok := pull(); ok; next, ok = pull() {
+			if !yield(idx, next) {
+				stop(iter)
+				return
+			}
+			idx += 1
+		}
+	}
+}
+
+// Pulled is an Iterable over the values produced by a pull function, such as
+// the pair returned by the standard library's iter.Pull.
+type Pulled[T any] struct {
+	next func() (T, bool)
+	stop func()
+	done bool
+}
+
+// FromPull creates an iterator from a pair of pull functions, as returned by
+// the standard library's iter.Pull.
+//
+// stop is called exactly once, either when next reports that it is exhausted
+// or when Stop is called. stop may be nil.
+func FromPull[T any](next func() (T, bool), stop func()) *Pulled[T] {
+	return &Pulled[T]{next, stop, false}
+}
+
+// FromSeq creates an iterator over a range-over-func sequence.
+//
+// The underlying pull is stopped automatically once the sequence is exhausted.
+// Callers abandoning iteration early should call Stop to release it.
+func FromSeq[T any](seq stditer.Seq[T]) *Pulled[T] {
+	return FromPull(stditer.Pull(seq))
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (p *Pulled[T]) Next() *T {
+	if p.done {
+		return nil
+	}
+
+	next, ok := p.next()
+	if !ok {
+		p.Stop()
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (p *Pulled[T]) NextValue() (T, bool) {
+	if p.done {
+		var zero T
+		return zero, false
+	}
+
+	next, ok := p.next()
+	if !ok {
+		p.Stop()
+	}
+
+	return next, ok
+}
+
+// Stop ends iteration, releasing the underlying pull.
+func (p *Pulled[T]) Stop() {
+	if p.done {
+		return
+	}
+
+	p.done = true
+	if p.stop != nil {
+		p.stop()
+	}
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (p *Pulled[T]) Fused() {}
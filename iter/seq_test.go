@@ -0,0 +1,165 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleSeq() {
+	i := iter.New([]int{1, 2, 3, 4})
+	double := func(n int) int { return n * 2 }
+
+	for val := range iter.Map[int, int](i, double).Seq() {
+		fmt.Println(val)
+	}
+	// Output:
+	// 2
+	// 4
+	// 6
+	// 8
+}
+
+func ExampleSeq2() {
+	i := iter.New([]string{"a", "b", "c"}).Skip(1)
+
+	for idx, val := range i.Seq2() {
+		fmt.Println(idx, val)
+	}
+	// Output:
+	// 0 b
+	// 1 c
+}
+
+func ExampleSeq_slices() {
+	isEven := func(n int) bool { return n%2 == 0 }
+	evens := iter.New([]int{4, 1, 2, 3, 6}).Filter(isEven)
+
+	fmt.Println(slices.Sorted(evens.Seq()))
+	// Output:
+	// [2 4 6]
+}
+
+func ExampleFromSeq() {
+	gt1 := func(n int) bool { return n > 1 }
+	i := iter.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	fmt.Println(i.Filter(gt1).Collect())
+	// Output:
+	// [2 3]
+}
+
+func ExampleFromPull() {
+	n := 0
+	next := func() (int, bool) {
+		n += 1
+		return n, n <= 3
+	}
+	i := iter.FromPull(next, nil)
+
+	fmt.Println(i.Collect())
+	// Output:
+	// [1 2 3]
+}
+
+func TestSeq_break(t *testing.T) {
+	i := iter.New([]int{1, 2, 3, 4})
+
+	for val := range i.Seq() {
+		if val == 2 {
+			break
+		}
+	}
+
+	// iteration may be resumed
+	if have := *i.Next(); have != 3 {
+		t.Errorf("Next \n\thave %v\n\twant %v", have, 3)
+	}
+}
+
+func TestFromSeq_break(t *testing.T) {
+	tests := map[string]func(*iter.Pulled[int]) iter.Iterable[int]{
+		"source":    func(p *iter.Pulled[int]) iter.Iterable[int] { return p },
+		"Skip":      func(p *iter.Pulled[int]) iter.Iterable[int] { return p.Skip(1) },
+		"Chain":     func(p *iter.Pulled[int]) iter.Iterable[int] { return iter.New([]int{0}).Chain(p) },
+		"StepBy":    func(p *iter.Pulled[int]) iter.Iterable[int] { return p.StepBy(1).Fuse().Peekable() },
+		"SkipWhile": func(p *iter.Pulled[int]) iter.Iterable[int] { return p.SkipWhile(func(int) bool { return false }) },
+		"Flatten": func(p *iter.Pulled[int]) iter.Iterable[int] {
+			return iter.Flatten[int](iter.Once[iter.Iterable[int]](p))
+		},
+	}
+
+	for name, adapt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stopped := false
+			seq := func(yield func(int) bool) {
+				defer func() { stopped = true }()
+				for n := 0; ; n++ {
+					if !yield(n) {
+						return
+					}
+				}
+			}
+
+			i := iter.FromSeq(seq)
+			for val := range iter.Seq(adapt(i)) {
+				if val == 2 {
+					break
+				}
+			}
+
+			if !stopped {
+				t.Errorf("expected early break to stop the underlying pull")
+			}
+			if have := i.Next(); have != nil {
+				t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+			}
+		})
+	}
+}
+
+func TestFromSeq_adapterEnds(t *testing.T) {
+	tests := map[string]func(*iter.Pulled[int]) iter.Iterable[int]{
+		"Take":      func(p *iter.Pulled[int]) iter.Iterable[int] { return p.Take(3) },
+		"Take zero": func(p *iter.Pulled[int]) iter.Iterable[int] { return p.Take(0) },
+		"TakeWhile": func(p *iter.Pulled[int]) iter.Iterable[int] { return p.TakeWhile(func(n int) bool { return n < 3 }) },
+		"Map Take": func(p *iter.Pulled[int]) iter.Iterable[int] {
+			return iter.Map(p, func(n int) int { return n }).Take(3)
+		},
+	}
+
+	for name, adapt := range tests {
+		t.Run(name, func(t *testing.T) {
+			seq := func(yield func(int) bool) {
+				for n := 0; yield(n); n++ {
+				}
+			}
+
+			// the adapter ends without the consumer breaking early
+			i := iter.FromSeq(seq)
+			iter.Collect(adapt(i))
+
+			if have := i.Next(); have != nil {
+				t.Errorf("expected the adapter ending to stop the underlying pull, Next \n\thave %v\n\twant <nil>", *have)
+			}
+		})
+	}
+}
+
+func TestFromSeq_exhausted(t *testing.T) {
+	stops := 0
+	next := func() (int, bool) { return 0, false }
+	i := iter.FromPull(next, func() { stops += 1 })
+
+	if have := i.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	i.Next()
+	i.Stop()
+
+	if stops != 1 {
+		t.Errorf("stop called %v times, want 1", stops)
+	}
+}
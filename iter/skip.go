@@ -3,6 +3,7 @@ package iter
 // Skipped is an iterator that skips over n elements.
 type Skipped[T any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	n    int
 }
 
@@ -12,7 +13,7 @@ func Skip[T any](iter Iterable[T], n int) *Skipped[T] {
 		panic("Skip requires n >= 0")
 	}
 
-	return &Skipped[T]{iter, n}
+	return &Skipped[T]{iter, valueFunc(iter), n}
 }
 
 // Next advances the iterator and returns the next value.
@@ -27,4 +28,74 @@ func (s *Skipped[T]) Next() *T {
 	return s.iter.Next()
 }
 
-//go:generate go run ./cmd/gen/ -name Skipped -output skip_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *Skipped[T]) NextValue() (T, bool) {
+	for s.n != 0 {
+		s.n -= 1
+		s.pull()
+	}
+
+	return s.pull()
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (s *Skipped[T]) NextBack() *T {
+	if lenOf(s.iter) <= s.n {
+		return nil
+	}
+
+	return back(s.iter).NextBack()
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended and its length is known exactly.
+func (s *Skipped[T]) DoubleEnded() bool {
+	_, ok := exactLen(s.iter)
+	return ok && IsDoubleEnded(s.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *Skipped[T]) SizeHint() (int, int, bool) {
+	lower, upper, ok := SizeHint(s.iter)
+	return max(lower-s.n, 0), max(upper-s.n, 0), ok
+}
+
+func (s *Skipped[T]) drain(n int) (int, bool) {
+	skipped, ok := drain(s.iter, s.n)
+	if !ok {
+		return 0, false
+	}
+
+	s.n -= skipped
+	if s.n != 0 {
+		// the underlying iterator ended first
+		s.n = 0
+		return 0, true
+	}
+
+	return drain(s.iter, n)
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (s *Skipped[T]) Stop() {
+	stop(s.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (s *Skipped[T]) Clone() Iterable[T] {
+	return Skip(clone(s.iter), s.n)
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (s *Skipped[T]) Cloneable() bool {
+	return IsCloneable(s.iter)
+}
@@ -3,13 +3,15 @@ package iter
 // SkipWhile is an Iterable that rejects elements while predicate returns true.
 type SkipWhileT[T any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	flag bool
 	pred func(T) bool
+	done bool
 }
 
 // SkipWhile creates an iterator that skips elements based on a predicate.
 func SkipWhile[T any](iter Iterable[T], pred func(T) bool) *SkipWhileT[T] {
-	return &SkipWhileT[T]{iter, false, pred}
+	return &SkipWhileT[T]{iter, valueFunc(iter), false, pred, false}
 }
 
 // Next advances the iterator and returns the next value.
@@ -27,7 +29,74 @@ func (s *SkipWhileT[T]) Next() *T {
 		}
 	}
 
-	return s.iter.Find(check(&s.flag, s.pred))
+	if s.done {
+		return nil
+	}
+
+	next := find(s.iter, check(&s.flag, s.pred))
+	if next == nil {
+		s.done = true
+	}
+
+	return next
 }
 
-//go:generate go run ./cmd/gen/ -name SkipWhileT -output skipWhile_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *SkipWhileT[T]) NextValue() (T, bool) {
+	if !s.done {
+		for next, ok := s.pull(); ok; next, ok = s.pull() {
+			if s.flag || !s.pred(next) {
+				s.flag = true
+				return next, true
+			}
+		}
+		s.done = true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *SkipWhileT[T]) SizeHint() (int, int, bool) {
+	if s.done {
+		return 0, 0, true
+	}
+
+	lower, upper, ok := SizeHint(s.iter)
+	if !s.flag {
+		// the predicate may yet reject every element
+		lower = 0
+	}
+
+	return lower, upper, ok
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *SkipWhileT[T]) Fused() {}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (s *SkipWhileT[T]) Stop() {
+	stop(s.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The predicate is shared between the clones.
+// Panics if Cloneable reports false.
+func (s *SkipWhileT[T]) Clone() Iterable[T] {
+	c := SkipWhile(clone(s.iter), s.pred)
+	c.flag, c.done = s.flag, s.done
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (s *SkipWhileT[T]) Cloneable() bool {
+	return IsCloneable(s.iter)
+}
@@ -3,8 +3,10 @@ package iter
 // Stepped is an Iterable for stepping iterators by a custom amount.
 type Stepped[T any] struct {
 	iter  Iterable[T]
+	pull  func() (T, bool)
 	step  int
 	first bool
+	done  bool
 }
 
 // StepBy creates an iterator starting at the same point, but stepping by the
@@ -19,24 +21,141 @@ func StepBy[T any](a Iterable[T], step int) *Stepped[T] {
 		panic("StepBy requires a step value > 0")
 	}
 
-	return &Stepped[T]{a, step, true}
+	return &Stepped[T]{a, valueFunc(a), step, true, false}
 }
 
 // Next advances the iterator and returns the next value.
 //
 // Returns nil when iteration is finished.
 func (s *Stepped[T]) Next() *T {
+	if s.done {
+		return nil
+	}
+
+	n := s.step
 	if s.first {
 		s.first = false
-		return s.iter.Next()
+		n = 1
 	}
 
-	next := s.iter.Next()
-	for i := 1; i < s.step; i++ {
+	var next *T
+	for ; n > 0; n-- {
 		next = s.iter.Next()
+		if next == nil {
+			s.done = true
+			return nil
+		}
 	}
 
 	return next
 }
 
-//go:generate go run ./cmd/gen/ -name Stepped -output stepBy_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *Stepped[T]) NextValue() (T, bool) {
+	var next T
+	if s.done {
+		return next, false
+	}
+
+	n := s.step
+	if s.first {
+		s.first = false
+		n = 1
+	}
+
+	for ; n > 0; n-- {
+		var ok bool
+		next, ok = s.pull()
+		if !ok {
+			s.done = true
+			return next, false
+		}
+	}
+
+	return next, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *Stepped[T]) SizeHint() (int, int, bool) {
+	steps := func(n int) int {
+		if !s.first {
+			return n / s.step
+		}
+		if n == 0 {
+			return 0
+		}
+
+		return 1 + (n-1)/s.step
+	}
+
+	if s.done {
+		return 0, 0, true
+	}
+
+	lower, upper, ok := SizeHint(s.iter)
+	return steps(lower), steps(upper), ok
+}
+
+func (s *Stepped[T]) drain(n int) (int, bool) {
+	if s.done {
+		return 0, true
+	}
+
+	rem, ok := exactLen(s.iter)
+	if _, canDrain := drain(s.iter, 0); !ok || !canDrain {
+		return 0, false
+	}
+	if n == 0 {
+		return 0, true
+	}
+
+	// count the elements remaining, as SizeHint does
+	total := rem / s.step
+	if s.first && rem > 0 {
+		total = 1 + (rem-1)/s.step
+	}
+
+	if n >= total {
+		drain(s.iter, rem)
+		s.done = true
+
+		return total, true
+	}
+
+	consumed := n * s.step
+	if s.first {
+		consumed = 1 + (n-1)*s.step
+	}
+	drain(s.iter, consumed)
+	s.first = false
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *Stepped[T]) Fused() {}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (s *Stepped[T]) Stop() {
+	stop(s.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (s *Stepped[T]) Clone() Iterable[T] {
+	c := StepBy(clone(s.iter), s.step)
+	c.first, c.done = s.first, s.done
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (s *Stepped[T]) Cloneable() bool {
+	return IsCloneable(s.iter)
+}
@@ -0,0 +1,400 @@
+package iter
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// RuneIndexIterator is an Iterable over the runes of a string and their byte
+// offsets.
+type RuneIndexIterator struct {
+	s           string
+	front, back int
+}
+
+// RuneIndices creates an iterator over the runes of s, each paired with the
+// byte offset at which it starts.
+//
+// Invalid UTF-8 is yielded as utf8.RuneError, one byte at a time, paired with
+// the offset of the offending byte.
+func RuneIndices(s string) *RuneIndexIterator {
+	return &RuneIndexIterator{s, 0, len(s)}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (r *RuneIndexIterator) Next() *Pair[int, rune] {
+	next, ok := r.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (r *RuneIndexIterator) NextValue() (Pair[int, rune], bool) {
+	if r.front >= r.back {
+		return Pair[int, rune]{}, false
+	}
+
+	char, size := utf8.DecodeRuneInString(r.s[r.front:r.back])
+	next := Pair[int, rune]{r.front, char}
+	r.front += size
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (r *RuneIndexIterator) NextBack() *Pair[int, rune] {
+	if r.front >= r.back {
+		return nil
+	}
+
+	char, size := utf8.DecodeLastRuneInString(r.s[r.front:r.back])
+	r.back -= size
+
+	return &Pair[int, rune]{r.back, char}
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (r *RuneIndexIterator) SizeHint() (int, int, bool) {
+	n := r.back - r.front
+	return (n + utf8.UTFMax - 1) / utf8.UTFMax, n, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (r *RuneIndexIterator) Clone() Iterable[Pair[int, rune]] {
+	c := *r
+	return &c
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *RuneIndexIterator) Fused() {}
+
+// RuneIterator is an Iterable over the runes of a string.
+type RuneIterator struct {
+	iter RuneIndexIterator
+}
+
+// Runes creates an iterator over the runes of s.
+//
+// Invalid UTF-8 is yielded as utf8.RuneError, one byte at a time; use
+// RuneIndices to find the offset of the offending byte.
+func Runes(s string) *RuneIterator {
+	return &RuneIterator{RuneIndexIterator{s, 0, len(s)}}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (r *RuneIterator) Next() *rune {
+	next, ok := r.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (r *RuneIterator) NextValue() (rune, bool) {
+	next, ok := r.iter.NextValue()
+	return next.Second, ok
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (r *RuneIterator) NextBack() *rune {
+	next := r.iter.NextBack()
+	if next == nil {
+		return nil
+	}
+
+	return &next.Second
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (r *RuneIterator) SizeHint() (int, int, bool) {
+	return r.iter.SizeHint()
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (r *RuneIterator) Clone() Iterable[rune] {
+	c := *r
+	return &c
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (r *RuneIterator) Fused() {}
+
+// ByteIterator is an Iterable over the bytes of a string.
+type ByteIterator struct {
+	s           string
+	front, back int
+}
+
+// Bytes creates an iterator over the bytes of s, without copying it.
+func Bytes(s string) *ByteIterator {
+	return &ByteIterator{s, 0, len(s)}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (b *ByteIterator) Next() *byte {
+	next, ok := b.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (b *ByteIterator) NextValue() (byte, bool) {
+	if b.front >= b.back {
+		return 0, false
+	}
+
+	next := b.s[b.front]
+	b.front += 1
+
+	return next, true
+}
+
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished.
+func (b *ByteIterator) NextBack() *byte {
+	if b.front >= b.back {
+		return nil
+	}
+
+	b.back -= 1
+	next := b.s[b.back]
+
+	return &next
+}
+
+// Len returns the number of elements remaining in the iterator.
+func (b *ByteIterator) Len() int {
+	return b.back - b.front
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (b *ByteIterator) SizeHint() (int, int, bool) {
+	n := b.Len()
+	return n, n, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (b *ByteIterator) Clone() Iterable[byte] {
+	c := *b
+	return &c
+}
+
+func (b *ByteIterator) drain(n int) (int, bool) {
+	n = min(n, b.Len())
+	b.front += n
+
+	return n, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (b *ByteIterator) Fused() {}
+
+// SplitIterator is an Iterable over the substrings of a string between
+// instances of a separator.
+type SplitIterator struct {
+	s, sep string
+	// n is the number of substrings remaining, or -1 if there is no limit.
+	n     int
+	after bool
+	done  bool
+}
+
+// SplitString creates an iterator over the substrings of s separated by sep.
+//
+// The substrings are those returned by strings.Split, produced one at a time.
+// If sep is empty, it splits after each UTF-8 sequence.
+func SplitString(s, sep string) *SplitIterator {
+	return &SplitIterator{s, sep, -1, false, false}
+}
+
+// SplitN creates an iterator over at most n substrings of s separated by sep,
+// the last of which is the unsplit remainder.
+//
+// If n is negative there is no limit, and if it is zero the iterator is empty,
+// as for strings.SplitN.
+func SplitN(s, sep string, n int) *SplitIterator {
+	return &SplitIterator{s, sep, n, false, n == 0}
+}
+
+// SplitAfter creates an iterator over the substrings of s after each instance
+// of sep, as for strings.SplitAfter.
+//
+// Each substring but the last includes its separator.
+func SplitAfter(s, sep string) *SplitIterator {
+	return &SplitIterator{s, sep, -1, true, false}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (s *SplitIterator) Next() *string {
+	next, ok := s.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *SplitIterator) NextValue() (string, bool) {
+	if s.done || (s.sep == "" && s.s == "") {
+		s.done = true
+		return "", false
+	}
+
+	if s.n > 0 {
+		s.n -= 1
+	}
+
+	// the end of the substring, and the start of the remainder
+	end, rest := -1, -1
+	switch {
+	case s.n == 0:
+	case s.sep == "":
+		_, size := utf8.DecodeRuneInString(s.s)
+		end, rest = size, size
+	default:
+		if i := strings.Index(s.s, s.sep); i >= 0 {
+			end, rest = i, i+len(s.sep)
+			if s.after {
+				end = rest
+			}
+		}
+	}
+
+	if end < 0 || (s.sep == "" && rest == len(s.s)) {
+		s.done = true
+		return s.s, true
+	}
+
+	next := s.s[:end]
+	s.s = s.s[rest:]
+
+	return next, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *SplitIterator) SizeHint() (int, int, bool) {
+	if s.done || (s.sep == "" && s.s == "") {
+		return 0, 0, true
+	}
+
+	upper := len(s.s) + 1
+	if s.n > 0 {
+		upper = min(upper, s.n)
+	}
+
+	return 1, upper, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+func (s *SplitIterator) Clone() Iterable[string] {
+	c := *s
+	return &c
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *SplitIterator) Fused() {}
+
+// FieldsIterator is an Iterable over the fields of a string.
+type FieldsIterator struct {
+	s     string
+	isSep func(rune) bool
+}
+
+// FieldsFunc creates an iterator over the runs of runes in s for which isSep
+// returns false, as for strings.FieldsFunc.
+//
+// Runs of separators are skipped, so no field is empty. isSep is called
+// lazily, as fields are requested.
+func FieldsFunc(s string, isSep func(rune) bool) *FieldsIterator {
+	return &FieldsIterator{s, isSep}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (f *FieldsIterator) Next() *string {
+	next, ok := f.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (f *FieldsIterator) NextValue() (string, bool) {
+	start := strings.IndexFunc(f.s, func(r rune) bool { return !f.isSep(r) })
+	if start < 0 {
+		f.s = ""
+		return "", false
+	}
+
+	f.s = f.s[start:]
+	end := strings.IndexFunc(f.s, f.isSep)
+	if end < 0 {
+		end = len(f.s)
+	}
+
+	next := f.s[:end]
+	f.s = f.s[end:]
+
+	return next, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (f *FieldsIterator) SizeHint() (int, int, bool) {
+	return 0, (len(f.s) + 1) / 2, true
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The separator function is shared between the clones.
+func (f *FieldsIterator) Clone() Iterable[string] {
+	c := *f
+	return &c
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (f *FieldsIterator) Fused() {}
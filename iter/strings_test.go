@@ -0,0 +1,155 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleRunes() {
+	fmt.Println(iter.Runes("héllo, wörld").Filter(unicode.IsLetter).Count())
+	// Output:
+	// 10
+}
+
+func ExampleRuneIndices() {
+	for p := range iter.RuneIndices("a\xffé").Seq() {
+		fmt.Printf("%d %q\n", p.First, p.Second)
+	}
+	// Output:
+	// 0 'a'
+	// 1 '�'
+	// 2 'é'
+}
+
+func ExampleBytes() {
+	fmt.Println(iter.Bytes("abc").Rev().Collect())
+	// Output:
+	// [99 98 97]
+}
+
+func ExampleSplitString() {
+	fmt.Printf("%q\n", iter.SplitString("a,b,c,d", ",").Take(2).Collect())
+	// Output:
+	// ["a" "b"]
+}
+
+func ExampleSplitN() {
+	fmt.Printf("%q\n", iter.SplitN("a,b,c,d", ",", 2).Collect())
+	// Output:
+	// ["a" "b,c,d"]
+}
+
+func ExampleSplitAfter() {
+	fmt.Printf("%q\n", iter.SplitAfter("a,b,c", ",").Collect())
+	// Output:
+	// ["a," "b," "c"]
+}
+
+func ExampleFieldsFunc() {
+	notLetter := func(r rune) bool { return !unicode.IsLetter(r) }
+
+	fmt.Printf("%q\n", iter.FieldsFunc("  foo1;bar2,baz3...", notLetter).Collect())
+	// Output:
+	// ["foo" "bar" "baz"]
+}
+
+var splitTests = []struct {
+	s, sep string
+}{
+	{"", ""},
+	{"", ","},
+	{",", ","},
+	{"abc", ""},
+	{"a\xffb\xe2\x82", ""},
+	{"a,b,c", ","},
+	{"a,b,c,", ","},
+	{",,a", ","},
+	{"a--b--c", "--"},
+	{"abc", "x"},
+	{"日本語", "本"},
+}
+
+func TestSplitString(t *testing.T) {
+	for _, tc := range splitTests {
+		have := iter.SplitString(tc.s, tc.sep).Collect()
+		if want := strings.Split(tc.s, tc.sep); !slices.Equal(have, want) {
+			t.Errorf("SplitString(%q, %q) \n\thave %q\n\twant %q", tc.s, tc.sep, have, want)
+		}
+
+		have = iter.SplitAfter(tc.s, tc.sep).Collect()
+		if want := strings.SplitAfter(tc.s, tc.sep); !slices.Equal(have, want) {
+			t.Errorf("SplitAfter(%q, %q) \n\thave %q\n\twant %q", tc.s, tc.sep, have, want)
+		}
+
+		for n := -1; n <= 4; n++ {
+			have = iter.SplitN(tc.s, tc.sep, n).Collect()
+			if want := strings.SplitN(tc.s, tc.sep, n); !slices.Equal(have, want) {
+				t.Errorf("SplitN(%q, %q, %v) \n\thave %q\n\twant %q", tc.s, tc.sep, n, have, want)
+			}
+		}
+	}
+}
+
+func TestSplitString_SizeHint(t *testing.T) {
+	for _, tc := range splitTests {
+		s := iter.SplitString(tc.s, tc.sep)
+		lower, upper, _ := s.SizeHint()
+
+		if n := s.Count(); n < lower || n > upper {
+			t.Errorf("SplitString(%q, %q) Count %v outside SizeHint %v, %v", tc.s, tc.sep, n, lower, upper)
+		}
+	}
+}
+
+func TestFieldsFunc(t *testing.T) {
+	for _, s := range []string{"", "   ", " a ", "a b  c", "\ta b\n", "a\xffb"} {
+		have := iter.FieldsFunc(s, unicode.IsSpace).Collect()
+		if want := strings.FieldsFunc(s, unicode.IsSpace); !slices.Equal(have, want) {
+			t.Errorf("FieldsFunc(%q) \n\thave %q\n\twant %q", s, have, want)
+		}
+	}
+}
+
+func TestRunes(t *testing.T) {
+	for _, s := range []string{"", "abc", "日本語", "a\xffb\xe2\x82", "\xe2\x82\xac\xe2"} {
+		have := iter.Runes(s).Collect()
+		if want := []rune(s); !slices.Equal(have, want) {
+			t.Errorf("Runes(%q) \n\thave %q\n\twant %q", s, have, want)
+		}
+
+		want := slices.Clone(have)
+		slices.Reverse(want)
+		if have := iter.Runes(s).Rev().Collect(); !slices.Equal(have, want) {
+			t.Errorf("Runes(%q).Rev \n\thave %q\n\twant %q", s, have, want)
+		}
+
+		if lower, upper, _ := iter.Runes(s).SizeHint(); len(have) < lower || len(have) > upper {
+			t.Errorf("Runes(%q) length %v outside SizeHint %v, %v", s, len(have), lower, upper)
+		}
+	}
+}
+
+func TestRuneIndices(t *testing.T) {
+	s := "a\xe2\x82é"
+	want := []iter.Pair[int, rune]{
+		{0, 'a'},
+		{1, utf8.RuneError},
+		{2, utf8.RuneError},
+		{3, 'é'},
+	}
+
+	if have := iter.RuneIndices(s).Collect(); !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+
+	slices.Reverse(want)
+	if have := iter.RuneIndices(s).Rev().Collect(); !slices.Equal(have, want) {
+		t.Errorf("Rev \n\thave %v\n\twant %v", have, want)
+	}
+}
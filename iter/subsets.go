@@ -0,0 +1,155 @@
+package iter
+
+import "math/bits"
+
+// maxSubsetItems is the most items that subsets can be enumerated for, as
+// each subset is represented by a bitmask.
+const maxSubsetItems = 63
+
+// Subsets is an Iterable over the subsets of the elements of a slice, in order
+// of size.
+type Subsets[T any] struct {
+	items []T
+	// k is the size of the current subsets, and maxK the largest size.
+	k, maxK int
+	// mask has a bit set for each item in the current subset.
+	mask    uint64
+	started bool
+	done    bool
+	buf     []T
+	copy    bool
+	rem     remaining
+}
+
+// Powerset creates an iterator over every subset of items, from the empty set
+// to items itself.
+//
+// Subsets are yielded in order of size, and subsets of the same size in
+// colexicographic order of the positions of their elements, with elements in
+// the order they appear in items. Elements at different positions are
+// distinct, even if they are equal.
+//
+// The yielded slice is reused by each call to Next; call Copy for a new slice
+// per subset.
+//
+// Panics if there are more than 63 items.
+func Powerset[T any](items []T) *Subsets[T] {
+	n := len(items)
+	if n > maxSubsetItems {
+		panic("Powerset supports at most 63 items")
+	}
+
+	rem := remaining{1 << n, n < maxSubsetItems}
+	return &Subsets[T]{items: items, k: 0, maxK: n, rem: rem}
+}
+
+// SubsetsOfSize creates an iterator over the subsets of k elements of items,
+// in the order described for Powerset.
+//
+// The yielded slice is reused by each call to Next; call Copy for a new slice
+// per subset. If k is greater than the number of items the iterator is empty.
+//
+// Panics if k is negative, or if there are more than 63 items.
+func SubsetsOfSize[T any](items []T, k int) *Subsets[T] {
+	if k < 0 {
+		panic("SubsetsOfSize k must not be negative")
+	}
+
+	n := len(items)
+	if n > maxSubsetItems {
+		panic("SubsetsOfSize supports at most 63 items")
+	}
+
+	return &Subsets[T]{items: items, k: k, maxK: k, done: k > n, rem: binomial(n, k)}
+}
+
+// Copy makes the iterator yield a new slice for each subset, rather than
+// reusing one, and returns the iterator.
+func (s *Subsets[T]) Copy() *Subsets[T] {
+	s.copy = true
+	return s
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (s *Subsets[T]) Next() *[]T {
+	next, ok := s.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *Subsets[T]) NextValue() ([]T, bool) {
+	if s.done || !s.advance() {
+		s.done = true
+		return nil, false
+	}
+	s.rem.next()
+
+	s.buf = s.buf[:0]
+	for m := s.mask; m != 0; m &= m - 1 {
+		s.buf = append(s.buf, s.items[bits.TrailingZeros64(m)])
+	}
+
+	if s.copy {
+		next := make([]T, len(s.buf))
+		copy(next, s.buf)
+		return next, true
+	}
+
+	return s.buf, true
+}
+
+// advance moves to the next subset, reporting whether there is one.
+func (s *Subsets[T]) advance() bool {
+	if !s.started {
+		s.started = true
+		s.mask = 1<<s.k - 1
+		return true
+	}
+
+	// Gosper's hack: the next larger mask with the same number of bits set
+	if s.mask != 0 {
+		low := s.mask & -s.mask
+		ripple := s.mask + low
+		s.mask = (((ripple ^ s.mask) >> 2) / low) | ripple
+
+		if s.mask < 1<<len(s.items) {
+			return true
+		}
+	}
+
+	s.k += 1
+	if s.k > s.maxK {
+		return false
+	}
+	s.mask = 1<<s.k - 1
+
+	return true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *Subsets[T]) SizeHint() (int, int, bool) {
+	if s.done {
+		return 0, 0, true
+	}
+
+	return s.rem.sizeHint()
+}
+
+// Len returns the number of elements remaining in the iterator.
+//
+// Panics if the number of subsets is too large to be counted by an int.
+func (s *Subsets[T]) Len() int {
+	return lenOf[[]T](s)
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *Subsets[T]) Fused() {}
@@ -0,0 +1,108 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExamplePowerset() {
+	fmt.Println(iter.Powerset([]string{"a", "b", "c"}).Copy().Collect())
+	// Output:
+	// [[] [a] [b] [c] [a b] [a c] [b c] [a b c]]
+}
+
+func ExamplePowerset_takeWhile() {
+	flags := []string{"x", "y", "z", "w"}
+	atMostOne := func(s []string) bool { return len(s) <= 1 }
+
+	for s := range iter.Powerset(flags).TakeWhile(atMostOne).Seq() {
+		fmt.Println(s)
+	}
+	// Output:
+	// []
+	// [x]
+	// [y]
+	// [z]
+	// [w]
+}
+
+func ExampleSubsetsOfSize() {
+	fmt.Println(iter.SubsetsOfSize([]int{1, 2, 3, 4}, 3).Copy().Collect())
+	// Output:
+	// [[1 2 3] [1 2 4] [1 3 4] [2 3 4]]
+}
+
+func TestPowerset(t *testing.T) {
+	for n := range 6 {
+		items := iter.Range(0, n).Collect()
+		p := iter.Powerset(items).Copy()
+
+		if have, want := p.Len(), 1<<n; have != want {
+			t.Errorf("Len of %v items \n\thave %v\n\twant %v", n, have, want)
+		}
+
+		have := p.Collect()
+		if len(have) != 1<<n {
+			t.Errorf("Collect of %v items \n\thave %v subsets\n\twant %v", n, len(have), 1<<n)
+		}
+		if !slices.IsSortedFunc(have, func(a, b []int) int { return len(a) - len(b) }) {
+			t.Errorf("Collect of %v items not ordered by size: %v", n, have)
+		}
+
+		seen := map[string]bool{}
+		for _, s := range have {
+			seen[fmt.Sprint(s)] = true
+		}
+		if len(seen) != len(have) {
+			t.Errorf("Collect of %v items has duplicates: %v", n, have)
+		}
+	}
+}
+
+func TestSubsetsOfSize(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4}
+
+	for k := range 7 {
+		have := iter.SubsetsOfSize(items, k).Copy().Collect()
+		want := iter.Combinations(items, k).Collect()
+
+		slices.SortFunc(have, slices.Compare[[]int])
+		if !slices.EqualFunc(have, want, slices.Equal[[]int]) {
+			t.Errorf("SubsetsOfSize(%v) \n\thave %v\n\twant %v", k, have, want)
+		}
+	}
+}
+
+func TestSubsets_reuse(t *testing.T) {
+	s := iter.SubsetsOfSize([]int{1, 2, 3}, 2)
+	first := *s.Next()
+	second := *s.Next()
+
+	if &first[0] != &second[0] {
+		t.Errorf("expected the slice to be reused")
+	}
+	if want := []int{1, 3}; !slices.Equal(first, want) {
+		t.Errorf("Next \n\thave %v\n\twant %v", first, want)
+	}
+}
+
+func TestPowerset_large(t *testing.T) {
+	p := iter.Powerset(make([]int, 63))
+
+	if _, _, ok := p.SizeHint(); ok {
+		t.Errorf("SizeHint of 63 items \n\thave exact\n\twant inexact")
+	}
+	if n := p.Take(64).Count(); n != 64 {
+		t.Errorf("Count \n\thave %v\n\twant %v", n, 64)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected 64 items to panic")
+		}
+	}()
+	iter.Powerset(make([]int, 64))
+}
@@ -0,0 +1,43 @@
+package iter
+
+// Successor is an Iterable where each element is computed from the one
+// before it.
+type Successor[T any] struct {
+	next *T
+	succ func(T) *T
+}
+
+// Successors creates an iterator which starts with first, and computes each
+// following element by calling succ on the preceding one.
+//
+// Iteration is finished when succ returns nil. If first is nil, the iterator
+// is empty.
+func Successors[T any](first *T, succ func(T) *T) *Successor[T] {
+	return &Successor[T]{first, succ}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (s *Successor[T]) Next() *T {
+	next := s.next
+	if next == nil {
+		return nil
+	}
+
+	s.next = s.succ(*next)
+
+	return next
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *Successor[T]) SizeHint() (int, int, bool) {
+	if s.next == nil {
+		return 0, 0, true
+	}
+
+	return 1, 0, false
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *Successor[T]) Fused() {}
@@ -0,0 +1,59 @@
+package iter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleSuccessors() {
+	powersOf10 := func(n uint16) *uint16 {
+		if n >= 10000 {
+			return nil
+		}
+		next := n * 10
+		return &next
+	}
+
+	fmt.Println(iter.Successors(ptr[uint16](1), powersOf10).Collect())
+	// Output:
+	// [1 10 100 1000 10000]
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
+
+func TestSuccessors_empty(t *testing.T) {
+	called := false
+	succ := func(n int) *int {
+		called = true
+		return &n
+	}
+	s := iter.Successors(nil, succ)
+
+	if have := s.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if called {
+		t.Errorf("successor function called on empty iterator")
+	}
+}
+
+func TestSuccessors_lazy(t *testing.T) {
+	calls := 0
+	succ := func(n int) *int {
+		calls += 1
+		next := n + 1
+		return &next
+	}
+
+	have := iter.Successors(ptr(0), succ).TakeWhile(func(n int) bool { return n < 3 }).Collect()
+	if len(have) != 3 {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, []int{0, 1, 2})
+	}
+	if calls != 4 {
+		t.Errorf("successor called %v times, want %v", calls, 4)
+	}
+}
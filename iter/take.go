@@ -3,13 +3,17 @@ package iter
 // Taken is an iterator that only iterates over the first n elements.
 type Taken[T any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	n    int
 }
 
 // Take creates an iterator that yields the first n elements, or fewer if the
 // underlying iterator ends sooner.
+//
+// Once n elements have been yielded, the underlying iterator is stopped if it
+// is a Stopper, as the rest of its elements are abandoned.
 func Take[T any](iter Iterable[T], n int) *Taken[T] {
-	return &Taken[T]{iter, n}
+	return &Taken[T]{iter, valueFunc(iter), n}
 }
 
 // Next advances the iterator and returns the next value.
@@ -17,11 +21,120 @@ func Take[T any](iter Iterable[T], n int) *Taken[T] {
 // Returns nil when iteration is finished.
 func (s *Taken[T]) Next() *T {
 	if s.n == 0 {
+		stop(s.iter)
+		return nil
+	}
+
+	next := s.iter.Next()
+	if next == nil {
+		s.n = 0
 		return nil
 	}
 
 	s.n -= 1
-	return s.iter.Next()
+	if s.n == 0 {
+		stop(s.iter)
+	}
+
+	return next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *Taken[T]) NextValue() (T, bool) {
+	if s.n == 0 {
+		stop(s.iter)
+
+		var zero T
+		return zero, false
+	}
+
+	next, ok := s.pull()
+	if !ok {
+		s.n = 0
+		return next, false
+	}
+
+	s.n -= 1
+	if s.n == 0 {
+		stop(s.iter)
+	}
+
+	return next, true
 }
 
-//go:generate go run ./cmd/gen/ -name Taken -output take_ext_gen.go
+// NextBack removes and returns an element from the end of the iterator.
+//
+// Returns nil when iteration is finished. Panics if DoubleEnded reports false.
+func (s *Taken[T]) NextBack() *T {
+	b := back(s.iter)
+
+	// discard the elements beyond the first n
+	for l := lenOf(s.iter); l > s.n; l-- {
+		b.NextBack()
+	}
+
+	if s.n == 0 {
+		return nil
+	}
+
+	s.n -= 1
+	return b.NextBack()
+}
+
+// DoubleEnded reports whether NextBack may be called, which is when the
+// underlying iterator is double ended and its length is known exactly.
+func (s *Taken[T]) DoubleEnded() bool {
+	_, ok := exactLen(s.iter)
+	return ok && IsDoubleEnded(s.iter)
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+func (s *Taken[T]) SizeHint() (int, int, bool) {
+	lower, upper, ok := SizeHint(s.iter)
+	if !ok {
+		upper = s.n
+	}
+
+	return min(lower, s.n), min(upper, s.n), true
+}
+
+func (s *Taken[T]) drain(n int) (int, bool) {
+	n = min(n, s.n)
+
+	count, ok := drain(s.iter, n)
+	if !ok {
+		return 0, false
+	}
+
+	s.n -= count
+	if count < n {
+		s.n = 0
+	}
+
+	return count, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *Taken[T]) Fused() {}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (s *Taken[T]) Stop() {
+	stop(s.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// Panics if Cloneable reports false.
+func (s *Taken[T]) Clone() Iterable[T] {
+	return Take(clone(s.iter), s.n)
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (s *Taken[T]) Cloneable() bool {
+	return IsCloneable(s.iter)
+}
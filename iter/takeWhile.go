@@ -3,6 +3,7 @@ package iter
 // TakeWhile is an Iterable that only yields elements while a predicate returns true.
 type TakeWhileT[T any] struct {
 	iter Iterable[T]
+	pull func() (T, bool)
 	flag bool
 	pred func(T) bool
 }
@@ -11,27 +12,95 @@ type TakeWhileT[T any] struct {
 //
 // TakeWhile takes a predicate function as an argument. It will call this
 // function on each element of the iterator, and yield elements while it returns
-// true.
+// true. Once it returns false, the underlying iterator is stopped if it is a
+// Stopper, as the rest of its elements are abandoned.
 func TakeWhile[T any](iter Iterable[T], pred func(T) bool) *TakeWhileT[T] {
-	return &TakeWhileT[T]{iter, true, pred}
+	return &TakeWhileT[T]{iter, valueFunc(iter), true, pred}
 }
 
 // Next advances the iterator and returns the next value.
 //
 // Returns nil when iteration is finished.
+//
+// The first element for which the predicate returns false is consumed, and
+// iteration ends.
 func (s *TakeWhileT[T]) Next() *T {
-	check := func(flag *bool, pred func(T) bool) func(T) bool {
-		return func(t T) bool {
-			if *flag && pred(t) {
-				return true
-			} else {
-				*flag = false
-				return false
-			}
-		}
+	if !s.flag {
+		return nil
+	}
+
+	next := s.iter.Next()
+	if next == nil {
+		s.flag = false
+		return nil
+	}
+	if !s.pred(*next) {
+		s.flag = false
+		stop(s.iter)
+		return nil
 	}
 
-	return s.iter.Find(check(&s.flag, s.pred))
+	return next
 }
 
-//go:generate go run ./cmd/gen/ -name TakeWhileT -output takeWhile_ext_gen.go
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (s *TakeWhileT[T]) NextValue() (T, bool) {
+	var next T
+	if !s.flag {
+		return next, false
+	}
+
+	next, ok := s.pull()
+	if !ok {
+		s.flag = false
+		return next, false
+	}
+	if !s.pred(next) {
+		s.flag = false
+		stop(s.iter)
+		return next, false
+	}
+
+	return next, true
+}
+
+// SizeHint returns the bounds on the remaining length of the iterator.
+//
+// The lower bound is always 0, as the predicate may fail on any element.
+func (s *TakeWhileT[T]) SizeHint() (int, int, bool) {
+	if !s.flag {
+		return 0, 0, true
+	}
+
+	_, upper, ok := SizeHint(s.iter)
+	return 0, upper, ok
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (s *TakeWhileT[T]) Fused() {}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (s *TakeWhileT[T]) Stop() {
+	stop(s.iter)
+}
+
+// Clone returns an independent copy of the iterator, positioned at the same
+// element.
+//
+// The predicate is shared between the clones.
+// Panics if Cloneable reports false.
+func (s *TakeWhileT[T]) Clone() Iterable[T] {
+	c := TakeWhile(clone(s.iter), s.pred)
+	c.flag = s.flag
+
+	return c
+}
+
+// Cloneable reports whether Clone may be called, which is when the underlying
+// iterator is cloneable.
+func (s *TakeWhileT[T]) Cloneable() bool {
+	return IsCloneable(s.iter)
+}
@@ -0,0 +1,395 @@
+package iter
+
+// order is the order in which a Traversal visits nodes.
+type order int
+
+const (
+	breadthFirst order = iota
+	preorder
+	postorder
+	deepening
+)
+
+// frame is a node on a Traversal's stack or queue.
+type frame[N any] struct {
+	node     N
+	depth    int
+	expanded bool
+	children []N
+	// i is the index of the next child to visit.
+	i int
+}
+
+// Traversal is an Iterable over the nodes of a graph or tree, reachable from a
+// root node.
+//
+// Each node is yielded once, as identified by its key; nodes reached again by
+// another path are skipped, so cycles are handled. The neighbors of a node are
+// only requested once traversal needs to move past it, so stopping early
+// avoids exploring the rest of the graph.
+type Traversal[N any, K comparable] struct {
+	root      N
+	neighbors func(N) []N
+	key       func(N) K
+	order     order
+	// maxDepth is the depth beyond which nodes are not visited, or -1 if there
+	// is no limit.
+	maxDepth int
+
+	started bool
+	done    bool
+	visited map[K]struct{}
+	stack   []frame[N]
+	// depth is the depth of the node most recently yielded.
+	depth int
+
+	// limit is the depth of the current pass of an iterative deepening search.
+	limit int
+	// best is the shallowest depth each node was reached at in the current
+	// pass of an iterative deepening search, or in a depth first search with a
+	// depth limit.
+	best map[K]int
+	// found reports whether the current pass of an iterative deepening search
+	// yielded any nodes.
+	found bool
+}
+
+func newTraversal[N any, K comparable](root N, neighbors func(N) []N, key func(N) K, o order) *Traversal[N, K] {
+	return &Traversal[N, K]{
+		root:      root,
+		neighbors: neighbors,
+		key:       key,
+		order:     o,
+		maxDepth:  -1,
+		visited:   map[K]struct{}{},
+	}
+}
+
+func identity[N any](node N) N {
+	return node
+}
+
+// BFS creates an iterator over the nodes reachable from root in breadth first
+// order, ie all nodes at depth 1 before any at depth 2, and so on.
+//
+// neighbors returns the nodes adjacent to a node, in the order they should be
+// visited.
+func BFS[N comparable](root N, neighbors func(N) []N) *Traversal[N, N] {
+	return BFSFunc(root, neighbors, identity[N])
+}
+
+// BFSFunc is like BFS, but identifies nodes by the key returned by key, for
+// nodes that are not comparable or are compared by some other identity.
+func BFSFunc[N any, K comparable](root N, neighbors func(N) []N, key func(N) K) *Traversal[N, K] {
+	return newTraversal(root, neighbors, key, breadthFirst)
+}
+
+// DFSPreorder creates an iterator over the nodes reachable from root in depth
+// first order, yielding each node before its descendants.
+//
+// neighbors returns the nodes adjacent to a node, in the order they should be
+// visited.
+func DFSPreorder[N comparable](root N, neighbors func(N) []N) *Traversal[N, N] {
+	return DFSPreorderFunc(root, neighbors, identity[N])
+}
+
+// DFSPreorderFunc is like DFSPreorder, but identifies nodes by the key returned
+// by key.
+func DFSPreorderFunc[N any, K comparable](root N, neighbors func(N) []N, key func(N) K) *Traversal[N, K] {
+	return newTraversal(root, neighbors, key, preorder)
+}
+
+// DFSPostorder creates an iterator over the nodes reachable from root in depth
+// first order, yielding each node after its descendants.
+//
+// neighbors returns the nodes adjacent to a node, in the order they should be
+// visited.
+func DFSPostorder[N comparable](root N, neighbors func(N) []N) *Traversal[N, N] {
+	return DFSPostorderFunc(root, neighbors, identity[N])
+}
+
+// DFSPostorderFunc is like DFSPostorder, but identifies nodes by the key
+// returned by key.
+func DFSPostorderFunc[N any, K comparable](root N, neighbors func(N) []N, key func(N) K) *Traversal[N, K] {
+	return newTraversal(root, neighbors, key, postorder)
+}
+
+// IDDFS creates an iterator over the nodes reachable from root by iterative
+// deepening depth first search.
+//
+// Nodes are yielded in order of their shortest depth from root, as for BFS,
+// but only the path to the current node is held on the stack, rather than a
+// whole level of the graph in a queue. The nodes reached are still recorded, to
+// yield each once, so memory remains proportional to the number of nodes
+// reached. In exchange, the shallower levels of the graph are explored again
+// for each level deeper. Use MaxDepth to bound the search.
+func IDDFS[N comparable](root N, neighbors func(N) []N) *Traversal[N, N] {
+	return IDDFSFunc(root, neighbors, identity[N])
+}
+
+// IDDFSFunc is like IDDFS, but identifies nodes by the key returned by key.
+func IDDFSFunc[N any, K comparable](root N, neighbors func(N) []N, key func(N) K) *Traversal[N, K] {
+	return newTraversal(root, neighbors, key, deepening)
+}
+
+// MaxDepth limits the traversal to nodes at most depth edges from the root, and
+// returns the iterator. The root is at depth 0.
+//
+// A depth first search expands a node again when it is reached by a shorter
+// path than before, so that every node within the limit is visited, though
+// each is still yielded once. In postorder, descendants only found that way
+// are yielded after the node.
+//
+// Panics if depth is negative.
+func (t *Traversal[N, K]) MaxDepth(depth int) *Traversal[N, K] {
+	if depth < 0 {
+		panic("MaxDepth depth must not be negative")
+	}
+
+	t.maxDepth = depth
+	return t
+}
+
+// Depth returns the depth of the node most recently returned by Next, ie the
+// number of edges between it and the root along the path traversed.
+func (t *Traversal[N, K]) Depth() int {
+	return t.depth
+}
+
+// visit marks a node as visited, and reports whether it had not been already.
+func (t *Traversal[N, K]) visit(node N) bool {
+	k := t.key(node)
+	if _, ok := t.visited[k]; ok {
+		return false
+	}
+
+	t.visited[k] = struct{}{}
+	return true
+}
+
+// reach reports whether node, reached at depth by a depth first search, should
+// be pushed to the stack. Without a depth limit each node is pushed once; with
+// one, a node is pushed again when reached by a shorter path, as its
+// descendants may have been beyond the limit along the longer one.
+func (t *Traversal[N, K]) reach(node N, depth int) bool {
+	if t.maxDepth < 0 {
+		return t.visit(node)
+	}
+
+	if t.best == nil {
+		t.best = map[K]int{}
+	}
+	k := t.key(node)
+	if best, ok := t.best[k]; ok && best <= depth {
+		return false
+	}
+
+	t.best[k] = depth
+	return true
+}
+
+// expand requests the children of f, unless they are beyond limit.
+func (t *Traversal[N, K]) expand(f *frame[N], limit int) {
+	f.expanded = true
+	if limit < 0 || f.depth < limit {
+		f.children = t.neighbors(f.node)
+	}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (t *Traversal[N, K]) Next() *N {
+	if t.done {
+		return nil
+	}
+
+	var next *N
+	switch t.order {
+	case breadthFirst:
+		next = t.nextBreadthFirst()
+	case preorder:
+		next = t.nextPreorder()
+	case postorder:
+		next = t.nextPostorder()
+	case deepening:
+		next = t.nextDeepening()
+	}
+
+	if next == nil {
+		t.done = true
+		t.stack = nil
+		t.visited = nil
+		t.best = nil
+	}
+
+	return next
+}
+
+func (t *Traversal[N, K]) nextBreadthFirst() *N {
+	if !t.started {
+		t.started = true
+		t.visit(t.root)
+		t.stack = append(t.stack, frame[N]{node: t.root})
+	} else {
+		// the stack is used as a queue; expand the node yielded last
+		f := t.stack[0]
+		t.expand(&f, t.maxDepth)
+		for _, child := range f.children {
+			if t.visit(child) {
+				t.stack = append(t.stack, frame[N]{node: child, depth: f.depth + 1})
+			}
+		}
+		t.stack = t.stack[1:]
+	}
+
+	if len(t.stack) == 0 {
+		return nil
+	}
+
+	next := t.stack[0].node
+	t.depth = t.stack[0].depth
+
+	return &next
+}
+
+func (t *Traversal[N, K]) nextPreorder() *N {
+	if !t.started {
+		t.started = true
+		t.reach(t.root, 0)
+		t.visit(t.root)
+		t.stack = append(t.stack, frame[N]{node: t.root})
+		t.depth = 0
+
+		root := t.root
+		return &root
+	}
+
+	for len(t.stack) > 0 {
+		top := &t.stack[len(t.stack)-1]
+		if !top.expanded {
+			t.expand(top, t.maxDepth)
+		}
+
+		if top.i < len(top.children) {
+			child := top.children[top.i]
+			top.i += 1
+			depth := top.depth + 1
+			if !t.reach(child, depth) {
+				continue
+			}
+
+			t.stack = append(t.stack, frame[N]{node: child, depth: depth})
+			// with a depth limit, a node reached again is expanded but not
+			// yielded again
+			if t.maxDepth < 0 || t.visit(child) {
+				t.depth = depth
+				return &child
+			}
+
+			continue
+		}
+
+		t.stack = t.stack[:len(t.stack)-1]
+	}
+
+	return nil
+}
+
+func (t *Traversal[N, K]) nextPostorder() *N {
+	if !t.started {
+		t.started = true
+		t.reach(t.root, 0)
+		t.stack = append(t.stack, frame[N]{node: t.root})
+	}
+
+	for len(t.stack) > 0 {
+		top := &t.stack[len(t.stack)-1]
+		if !top.expanded {
+			t.expand(top, t.maxDepth)
+		}
+
+		if top.i < len(top.children) {
+			child := top.children[top.i]
+			top.i += 1
+			if t.reach(child, top.depth+1) {
+				t.stack = append(t.stack, frame[N]{node: child, depth: top.depth + 1})
+			}
+
+			continue
+		}
+
+		next, depth := top.node, top.depth
+		t.stack = t.stack[:len(t.stack)-1]
+
+		// with a depth limit, a node reached again is not yielded again
+		if t.maxDepth >= 0 && !t.visit(next) {
+			continue
+		}
+
+		t.depth = depth
+		return &next
+	}
+
+	return nil
+}
+
+// nextDeepening performs successive depth limited searches, yielding the nodes
+// first reached at the limit of each.
+func (t *Traversal[N, K]) nextDeepening() *N {
+	if !t.started {
+		t.started = true
+		t.startPass()
+	}
+
+	for {
+		for len(t.stack) > 0 {
+			top := &t.stack[len(t.stack)-1]
+			if !top.expanded {
+				t.expand(top, t.limit)
+				if top.depth == t.limit && t.visit(top.node) {
+					t.found = true
+					t.depth = top.depth
+
+					next := top.node
+					return &next
+				}
+			}
+
+			if top.i < len(top.children) {
+				child := top.children[top.i]
+				top.i += 1
+
+				// revisit nodes reached by a shorter path than before
+				k, depth := t.key(child), top.depth+1
+				if best, ok := t.best[k]; ok && best <= depth {
+					continue
+				}
+				t.best[k] = depth
+				t.stack = append(t.stack, frame[N]{node: child, depth: depth})
+
+				continue
+			}
+
+			t.stack = t.stack[:len(t.stack)-1]
+		}
+
+		// no nodes at this depth means none deeper
+		if !t.found || t.limit == t.maxDepth {
+			return nil
+		}
+
+		t.limit += 1
+		t.startPass()
+	}
+}
+
+// startPass begins a depth limited search of an iterative deepening search.
+func (t *Traversal[N, K]) startPass() {
+	t.found = false
+	t.best = map[K]int{t.key(t.root): 0}
+	t.stack = append(t.stack[:0], frame[N]{node: t.root})
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (t *Traversal[N, K]) Fused() {}
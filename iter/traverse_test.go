@@ -0,0 +1,199 @@
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+//	    1
+//	   / \
+//	  2   3
+//	 / \ / \
+//	4   5   6
+//	|      /
+//	7 <---
+//
+// with an edge from 7 back to 1.
+var graph = map[int][]int{
+	1: {2, 3},
+	2: {4, 5},
+	3: {5, 6},
+	4: {7},
+	6: {7},
+	7: {1},
+}
+
+func neighbors(n int) []int {
+	return graph[n]
+}
+
+func ExampleBFS() {
+	fmt.Println(iter.BFS(1, neighbors).Collect())
+	// Output:
+	// [1 2 3 4 5 6 7]
+}
+
+func ExampleDFSPreorder() {
+	fmt.Println(iter.DFSPreorder(1, neighbors).Collect())
+	// Output:
+	// [1 2 4 7 5 3 6]
+}
+
+func ExampleDFSPostorder() {
+	fmt.Println(iter.DFSPostorder(1, neighbors).Collect())
+	// Output:
+	// [7 4 5 2 6 3 1]
+}
+
+func ExampleIDDFS() {
+	t := iter.IDDFS(1, neighbors)
+
+	for n := t.Next(); n != nil; n = t.Next() {
+		fmt.Println(*n, t.Depth())
+	}
+	// Output:
+	// 1 0
+	// 2 1
+	// 3 1
+	// 4 2
+	// 5 2
+	// 6 2
+	// 7 3
+}
+
+func ExampleTraversal_MaxDepth() {
+	fmt.Println(iter.DFSPreorder(1, neighbors).MaxDepth(1).Collect())
+	// Output:
+	// [1 2 3]
+}
+
+func TestTraversal_depth(t *testing.T) {
+	tests := map[string]struct {
+		it   *iter.Traversal[int, int]
+		want []int
+	}{
+		"BFS":          {iter.BFS(1, neighbors), []int{0, 1, 1, 2, 2, 2, 3}},
+		"DFSPreorder":  {iter.DFSPreorder(1, neighbors), []int{0, 1, 2, 3, 2, 1, 2}},
+		"DFSPostorder": {iter.DFSPostorder(1, neighbors), []int{3, 2, 2, 1, 2, 1, 0}},
+		"IDDFS":        {iter.IDDFS(1, neighbors), []int{0, 1, 1, 2, 2, 2, 3}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var have []int
+			for n := tc.it.Next(); n != nil; n = tc.it.Next() {
+				have = append(have, tc.it.Depth())
+			}
+
+			if !slices.Equal(have, tc.want) {
+				t.Errorf("Depth \n\thave %v\n\twant %v", have, tc.want)
+			}
+		})
+	}
+}
+
+func TestTraversal_MaxDepth(t *testing.T) {
+	tests := map[string]struct {
+		it   *iter.Traversal[int, int]
+		want []int
+	}{
+		"BFS":          {iter.BFS(1, neighbors).MaxDepth(2), []int{1, 2, 3, 4, 5, 6}},
+		"DFSPreorder":  {iter.DFSPreorder(1, neighbors).MaxDepth(2), []int{1, 2, 4, 5, 3, 6}},
+		"DFSPostorder": {iter.DFSPostorder(1, neighbors).MaxDepth(2), []int{4, 5, 2, 6, 3, 1}},
+		"IDDFS":        {iter.IDDFS(1, neighbors).MaxDepth(2), []int{1, 2, 3, 4, 5, 6}},
+		"zero":         {iter.BFS(1, neighbors).MaxDepth(0), []int{1}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.it.Collect(); !slices.Equal(have, tc.want) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, tc.want)
+			}
+		})
+	}
+}
+
+func TestTraversal_lazy(t *testing.T) {
+	expanded := map[int]bool{}
+	counting := func(n int) []int {
+		expanded[n] = true
+		return neighbors(n)
+	}
+
+	have := iter.BFS(1, counting).Find(func(n int) bool { return n == 3 })
+	if have == nil || *have != 3 {
+		t.Errorf("Find \n\thave %v\n\twant %v", have, 3)
+	}
+	if want := map[int]bool{1: true, 2: true}; len(expanded) != len(want) || !expanded[1] || !expanded[2] {
+		t.Errorf("expanded \n\thave %v\n\twant %v", expanded, want)
+	}
+}
+
+func TestTraversal_key(t *testing.T) {
+	type node struct {
+		id int
+	}
+	byID := func(n *node) int { return n.id }
+	children := func(n *node) []*node {
+		var nodes []*node
+		for _, id := range graph[n.id] {
+			// a new node each time, so identity is only by key
+			nodes = append(nodes, &node{id})
+		}
+		return nodes
+	}
+
+	trav := iter.DFSPreorderFunc(&node{1}, children, byID)
+	have := iter.Map[*node, int](trav, byID).Collect()
+	if want := []int{1, 2, 4, 7, 5, 3, 6}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestIDDFS_shortestDepth(t *testing.T) {
+	// 3 is first reached at depth 2 by the depth first pass, but its shortest
+	// depth is 1; 4 is only reachable through 3
+	g := map[int][]int{
+		1: {2, 3},
+		2: {3},
+		3: {4},
+	}
+	next := func(n int) []int { return g[n] }
+
+	have := iter.IDDFS(1, next).MaxDepth(2).Collect()
+	if want := []int{1, 2, 3, 4}; !slices.Equal(have, want) {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestTraversal_MaxDepth_shorterPath(t *testing.T) {
+	// 2 is first reached at depth 2 by the depth first searches, too deep to
+	// expand, but 3 is within the limit through the shorter path 0, 2, 3
+	g := map[int][]int{
+		0: {1, 2},
+		1: {2},
+		2: {3},
+	}
+	next := func(n int) []int { return g[n] }
+
+	tests := map[string]struct {
+		it   *iter.Traversal[int, int]
+		want []int
+	}{
+		"BFS":          {iter.BFS(0, next).MaxDepth(2), []int{0, 1, 2, 3}},
+		"DFSPreorder":  {iter.DFSPreorder(0, next).MaxDepth(2), []int{0, 1, 2, 3}},
+		"DFSPostorder": {iter.DFSPostorder(0, next).MaxDepth(2), []int{2, 1, 3, 0}},
+		"IDDFS":        {iter.IDDFS(0, next).MaxDepth(2), []int{0, 1, 2, 3}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if have := tc.it.Collect(); !slices.Equal(have, tc.want) {
+				t.Errorf("Collect \n\thave %v\n\twant %v", have, tc.want)
+			}
+		})
+	}
+}
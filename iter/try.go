@@ -0,0 +1,260 @@
+package iter
+
+// TryIterable is an iterator over values that may fail to be produced, such as
+// records read from a file or a database.
+type TryIterable[T any] interface {
+	// Next advances the iterator and returns the next value.
+	//
+	// Returns nil and a nil error when iteration is finished. Returns nil and a
+	// non-nil error if the next value could not be produced.
+	Next() (*T, error)
+}
+
+// Infallible is a TryIterable over an Iterable, which never fails.
+type Infallible[T any] struct {
+	iter Iterable[T]
+}
+
+// TryFrom creates a TryIterable over an Iterable, for use with the fallible
+// adapters and consumers.
+func TryFrom[T any](iter Iterable[T]) *Infallible[T] {
+	return &Infallible[T]{iter}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished. The returned error is always nil.
+func (i *Infallible[T]) Next() (*T, error) {
+	return i.iter.Next(), nil
+}
+
+// Caught is an Iterable over a TryIterable, which ends at the first error.
+type Caught[T any] struct {
+	iter TryIterable[T]
+	err  error
+}
+
+// Catch creates an Iterable over a TryIterable, so that it may be used with the
+// infallible adapters and consumers.
+//
+// Iteration ends at the first error, which is then available from Err.
+func Catch[T any](iter TryIterable[T]) *Caught[T] {
+	return &Caught[T]{iter, nil}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished, or if the underlying iterator
+// returned an error.
+func (c *Caught[T]) Next() *T {
+	if c.err != nil {
+		return nil
+	}
+
+	next, err := c.iter.Next()
+	if err != nil {
+		c.err = err
+		return nil
+	}
+
+	return next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (c *Caught[T]) NextValue() (T, bool) {
+	next := c.Next()
+	if next == nil {
+		var zero T
+		return zero, false
+	}
+
+	return *next, true
+}
+
+// Err returns the first error returned by the underlying iterator, if any.
+func (c *Caught[T]) Err() error {
+	return c.err
+}
+
+// Stop stops the underlying iterator, if it is a Stopper.
+func (c *Caught[T]) Stop() {
+	if s, ok := c.iter.(Stopper); ok {
+		s.Stop()
+	}
+}
+
+// TryMapped is a TryIterable that applies a fallible function to every element.
+type TryMapped[T any, O any] struct {
+	iter TryIterable[T]
+	fn   func(T) (O, error)
+}
+
+// TryMap returns a fallible iterator that applies a fallible function to every
+// element.
+//
+// Errors from either the underlying iterator or the function are returned by
+// Next.
+func TryMap[T any, O any](iter TryIterable[T], fn func(T) (O, error)) *TryMapped[T, O] {
+	return &TryMapped[T, O]{iter, fn}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil and a nil error when iteration is finished.
+func (m *TryMapped[T, O]) Next() (*O, error) {
+	next, err := m.iter.Next()
+	if next == nil || err != nil {
+		return nil, err
+	}
+
+	result, err := m.fn(*next)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TryFiltered is a TryIterable that uses a fallible predicate to determine if
+// an element should be yielded.
+type TryFiltered[T any] struct {
+	iter TryIterable[T]
+	pred func(T) (bool, error)
+}
+
+// TryFilter returns a fallible iterator which uses a fallible predicate to
+// determine if an element should be yielded.
+//
+// Errors from either the underlying iterator or the predicate are returned by
+// Next.
+func TryFilter[T any](iter TryIterable[T], pred func(T) (bool, error)) *TryFiltered[T] {
+	return &TryFiltered[T]{iter, pred}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil and a nil error when iteration is finished.
+func (f *TryFiltered[T]) Next() (*T, error) {
+	return TryFind(f.iter, f.pred)
+}
+
+// TryFlatMapped is a TryIterable that maps each element to a fallible iterator,
+// and yields the elements of those iterators in sequence.
+type TryFlatMapped[T any, O any] struct {
+	iter  TryIterable[T]
+	fn    func(T) (TryIterable[O], error)
+	inner TryIterable[O]
+}
+
+// TryFlatMap returns a fallible iterator that maps each element to a fallible
+// iterator, and yields the elements of those iterators in sequence.
+//
+// Errors from the underlying iterator, the function, or the mapped iterators
+// are returned by Next.
+func TryFlatMap[T any, O any](iter TryIterable[T], fn func(T) (TryIterable[O], error)) *TryFlatMapped[T, O] {
+	return &TryFlatMapped[T, O]{iter, fn, nil}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil and a nil error when iteration is finished.
+func (f *TryFlatMapped[T, O]) Next() (*O, error) {
+	for {
+		if f.inner != nil {
+			next, err := f.inner.Next()
+			if next != nil || err != nil {
+				return next, err
+			}
+			f.inner = nil
+		}
+
+		outer, err := f.iter.Next()
+		if outer == nil || err != nil {
+			return nil, err
+		}
+
+		f.inner, err = f.fn(*outer)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// TryCollect transforms a fallible iterator into a slice, stopping at the first
+// error.
+//
+// The elements collected before the error are returned along with it.
+func TryCollect[T any](iter TryIterable[T]) ([]T, error) {
+	var out []T
+
+	for {
+		next, err := iter.Next()
+		if next == nil || err != nil {
+			return out, err
+		}
+
+		out = append(out, *next)
+	}
+}
+
+// TryFold repeatedly applies a fallible reducing operation, reducing the
+// iterator to a single element.
+//
+// TryFold stops at the first error from either the iterator or the function,
+// returning the accumulated value so far along with the error.
+func TryFold[T any, O any](iter TryIterable[T], init O, fn func(O, T) (O, error)) (O, error) {
+	accum := init
+
+	for {
+		next, err := iter.Next()
+		if next == nil || err != nil {
+			return accum, err
+		}
+
+		result, err := fn(accum, *next)
+		if err != nil {
+			return accum, err
+		}
+		accum = result
+	}
+}
+
+// TryForEach calls a fallible function on each element of a fallible
+// iterator, stopping at the first error.
+func TryForEach[T any](iter TryIterable[T], fn func(T) error) error {
+	for {
+		next, err := iter.Next()
+		if next == nil || err != nil {
+			return err
+		}
+
+		if err := fn(*next); err != nil {
+			return err
+		}
+	}
+}
+
+// TryFind searches for an element of a fallible iterator that satisfies a
+// fallible predicate.
+//
+// TryFind is short-circuiting; it stops at the first element for which the
+// predicate returns true, or at the first error.
+func TryFind[T any](iter TryIterable[T], pred func(T) (bool, error)) (*T, error) {
+	for {
+		next, err := iter.Next()
+		if next == nil || err != nil {
+			return nil, err
+		}
+
+		ok, err := pred(*next)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return next, nil
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package iter_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleTryMap() {
+	i := iter.TryFrom[string](iter.New([]string{"1", "2", "x", "4"}))
+
+	nums, err := iter.TryCollect[int](iter.TryMap(i, strconv.Atoi))
+	fmt.Println(nums)
+	fmt.Println(err)
+	// Output:
+	// [1 2]
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleTryFilter() {
+	isEven := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+	i := iter.TryFrom[string](iter.New([]string{"1", "2", "3", "4"}))
+
+	evens, err := iter.TryCollect[string](iter.TryFilter(i, isEven))
+	fmt.Println(evens, err)
+	// Output:
+	// [2 4] <nil>
+}
+
+func ExampleTryFlatMap() {
+	split := func(s string) (iter.TryIterable[rune], error) {
+		if s == "" {
+			return nil, errors.New("empty word")
+		}
+		return iter.TryFrom[rune](iter.New([]rune(s))), nil
+	}
+	i := iter.TryFrom[string](iter.New([]string{"ab", "c", "", "d"}))
+
+	runes, err := iter.TryCollect[rune](iter.TryFlatMap(i, split))
+	fmt.Println(string(runes), err)
+	// Output:
+	// abc empty word
+}
+
+func ExampleTryFold() {
+	sum := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}
+
+	fmt.Println(iter.TryFold(iter.TryFrom[string](iter.New([]string{"1", "2", "3"})), 0, sum))
+	// Output:
+	// 6 <nil>
+}
+
+func ExampleTryForEach() {
+	show := func(s string) error {
+		if s == "stop" {
+			return errors.New("stopped")
+		}
+		fmt.Println(s)
+		return nil
+	}
+	i := iter.TryFrom[string](iter.New([]string{"a", "b", "stop", "c"}))
+
+	fmt.Println(iter.TryForEach(i, show))
+	// Output:
+	// a
+	// b
+	// stopped
+}
+
+func ExampleTryFind() {
+	gt2 := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n > 2, err
+	}
+	i := iter.TryFrom[string](iter.New([]string{"1", "3", "x"}))
+
+	found, err := iter.TryFind(i, gt2)
+	fmt.Println(*found, err)
+	found, err = iter.TryFind(i, gt2)
+	fmt.Println(found, err)
+	// Output:
+	// 3 <nil>
+	// <nil> strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleCatch() {
+	i := iter.TryFrom[string](iter.New([]string{"1", "2", "x", "4"}))
+	nums := iter.Catch[int](iter.TryMap(i, strconv.Atoi))
+
+	double := func(n int) int { return n * 2 }
+	fmt.Println(iter.Map[int, int](nums, double).Collect())
+	fmt.Println(nums.Err())
+	// Output:
+	// [2 4]
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func TestCatch_stopsAtError(t *testing.T) {
+	calls := 0
+	fail := func(n int) (int, error) {
+		calls += 1
+		return 0, errors.New("failed")
+	}
+	c := iter.Catch[int](iter.TryMap(iter.TryFrom[int](iter.New([]int{1, 2, 3})), fail))
+
+	for i := 0; i < 3; i++ {
+		if have := c.Next(); have != nil {
+			t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("underlying iterator polled %v times after error, want 1", calls)
+	}
+	if c.Err() == nil {
+		t.Errorf("expected error to be recorded")
+	}
+}
@@ -0,0 +1,54 @@
+package iter
+
+// Unfolded is an Iterable driven by a state machine.
+type Unfolded[T, S any] struct {
+	state S
+	fn    func(S) (T, S, bool)
+	done  bool
+}
+
+// Unfold creates an iterator from an initial state and a step function.
+//
+// On each iteration fn is called with the current state and returns the next
+// element, the following state, and whether there was an element. Iteration is
+// finished the first time fn returns false, after which fn is not called
+// again.
+func Unfold[T, S any](state S, fn func(S) (T, S, bool)) *Unfolded[T, S] {
+	return &Unfolded[T, S]{state, fn, false}
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (u *Unfolded[T, S]) Next() *T {
+	next, ok := u.NextValue()
+	if !ok {
+		return nil
+	}
+
+	return &next
+}
+
+// NextValue advances the iterator and returns the next value, and whether there
+// was one.
+//
+// Returns the zero value and false when iteration is finished.
+func (u *Unfolded[T, S]) NextValue() (T, bool) {
+	var next T
+	if u.done {
+		return next, false
+	}
+
+	next, state, ok := u.fn(u.state)
+	if !ok {
+		u.done = true
+		var zero T
+		return zero, false
+	}
+	u.state = state
+
+	return next, true
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (u *Unfolded[T, S]) Fused() {}
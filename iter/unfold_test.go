@@ -0,0 +1,37 @@
+package iter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleUnfold() {
+	fib := func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, true
+	}
+
+	fmt.Println(iter.Unfold([2]int{0, 1}, fib).Take(8).Collect())
+	// Output:
+	// [0 1 1 2 3 5 8 13]
+}
+
+func TestUnfold_fused(t *testing.T) {
+	calls := 0
+	countdown := func(n int) (int, int, bool) {
+		calls += 1
+		return n, n - 1, n > 0
+	}
+	u := iter.Unfold(2, countdown)
+
+	if have := u.Collect(); len(have) != 2 {
+		t.Errorf("Collect \n\thave %v\n\twant %v", have, []int{2, 1})
+	}
+	if have := u.Next(); have != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *have)
+	}
+	if calls != 3 {
+		t.Errorf("step function called %v times, want %v", calls, 3)
+	}
+}
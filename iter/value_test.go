@@ -0,0 +1,157 @@
+package iter_test
+
+import (
+	"container/list"
+	"fmt"
+	"testing"
+
+	"github.com/partylich/go/iter"
+)
+
+func ExampleValueIterable() {
+	i := iter.Map[int, int](iter.New([]int{1, 2}), func(n int) int { return n * 2 })
+
+	for val, ok := i.NextValue(); ok; val, ok = i.NextValue() {
+		fmt.Println(val)
+	}
+	// Output:
+	// 2
+	// 4
+}
+
+func TestNextValue(t *testing.T) {
+	nums := []int{1, 2, 3, 4, 5, 6}
+	isOdd := func(i int) bool { return i%2 != 0 }
+	double := func(i int) int { return i * 2 }
+	l := list.New()
+	for _, v := range nums[:3] {
+		l.PushBack(v)
+	}
+
+	cases := []struct {
+		name string
+		it   iter.ValueIterable[int]
+		want []int
+	}{
+		{"Iterator", iter.New(nums), nums},
+		{"RevIterator", iter.New(nums).Rev(), []int{6, 5, 4, 3, 2, 1}},
+		{"ListIterator", iter.FromList[int](l), []int{1, 2, 3}},
+		{"Reversed", iter.Rev[int](iter.New(nums[:3])), []int{3, 2, 1}},
+		{"Mapped", iter.Map[int, int](iter.New(nums[:3]), double), []int{2, 4, 6}},
+		{"Filtered", iter.Filter[int](iter.New(nums), isOdd), []int{1, 3, 5}},
+		{"Chained", iter.Chain[int](iter.New(nums[:2]), iter.New(nums[4:])), []int{1, 2, 5, 6}},
+		{"Skipped", iter.Skip[int](iter.New(nums), 4), []int{5, 6}},
+		{"Taken", iter.Take[int](iter.New(nums), 2), []int{1, 2}},
+		{"Stepped", iter.StepBy[int](iter.New(nums), 4), []int{1, 5}},
+		{"SkipWhileT", iter.SkipWhile[int](iter.New([]int{1, 3, 4, 5}), isOdd), []int{4, 5}},
+		{"TakeWhileT", iter.TakeWhile[int](iter.New([]int{1, 3, 4, 5}), isOdd), []int{1, 3}},
+		{"Flat", iter.Flatten[int](iter.New([]iter.Iterable[int]{iter.New(nums[:2]), iter.New(nums[5:])})), []int{1, 2, 6}},
+		{"Fused", iter.Fuse[int](iter.New(nums[:2])), []int{1, 2}},
+		{"PeekableT", iter.Peekable[int](iter.New(nums[:2])), []int{1, 2}},
+		{"Pulled", iter.FromPull(iter.New(nums[:2]).NextValue, nil), []int{1, 2}},
+	}
+
+	for _, c := range cases {
+		var have []int
+		for val, ok := c.it.NextValue(); ok; val, ok = c.it.NextValue() {
+			have = append(have, val)
+		}
+
+		if fmt.Sprint(have) != fmt.Sprint(c.want) {
+			t.Errorf("%v NextValue \n\thave %v\n\twant %v", c.name, have, c.want)
+		}
+		if _, ok := c.it.NextValue(); ok {
+			t.Errorf("%v NextValue: expected iteration to be finished", c.name)
+		}
+	}
+}
+
+// chain builds a Map, Filter, Take chain over a slice of n elements.
+func chain(n int) *iter.Taken[int] {
+	double := func(i int) int { return i * 2 }
+	isPos := func(i int) bool { return i >= 0 }
+
+	return iter.Map[int, int](iter.New(make([]int, n)), double).
+		Filter(isPos).
+		Take(n)
+}
+
+func TestNextValue_allocs(t *testing.T) {
+	const runs = 100
+	c := chain(runs + 1)
+
+	allocs := testing.AllocsPerRun(runs, func() {
+		c.NextValue()
+	})
+	if allocs != 0 {
+		t.Errorf("NextValue allocated %v times per element, want 0", allocs)
+	}
+}
+
+func TestConsumers_allocs(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	isNeg := func(i int) bool { return i < 0 }
+
+	consumers := []struct {
+		name string
+		fn   func(iter.Iterable[int])
+	}{
+		{"Reduce", func(it iter.Iterable[int]) { iter.Reduce(it, 0, sum) }},
+		{"Count", func(it iter.Iterable[int]) { iter.Count(it) }},
+		{"ForEach", func(it iter.Iterable[int]) { iter.ForEach(it, func(int) {}) }},
+		{"Any", func(it iter.Iterable[int]) { iter.Any(it, isNeg) }},
+	}
+
+	for _, c := range consumers {
+		chains := make([]*iter.Taken[int], 101)
+		for i := range chains {
+			chains[i] = chain(64)
+		}
+
+		idx := 0
+		allocs := testing.AllocsPerRun(100, func() {
+			c.fn(chains[idx])
+			idx += 1
+		})
+		if allocs != 0 {
+			t.Errorf("%v allocated %v times, want 0", c.name, allocs)
+		}
+	}
+}
+
+func BenchmarkChain_NextValue(b *testing.B) {
+	c := chain(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		c.NextValue()
+	}
+}
+
+func BenchmarkChain_Next(b *testing.B) {
+	c := chain(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		c.Next()
+	}
+}
+
+func BenchmarkChain_Reduce(b *testing.B) {
+	sum := func(a, b int) int { return a + b }
+	c := chain(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	iter.Reduce[int](c, 0, sum)
+}
+
+func BenchmarkChain_Collect(b *testing.B) {
+	c := chain(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	iter.Collect[int](c)
+}
@@ -0,0 +1,188 @@
+package iter
+
+import (
+	"io/fs"
+	"path"
+)
+
+// WalkEntry is an entry in a file system, as yielded by WalkFS.
+type WalkEntry struct {
+	// Path is the path of the entry, joined to the root of the walk.
+	Path string
+	// Entry describes the file or directory. It is nil if Err was returned
+	// while getting information about the root.
+	Entry fs.DirEntry
+	// Err is any error encountered while getting information about the entry,
+	// or reading its contents if it is a directory.
+	Err error
+}
+
+// SymlinkPolicy determines how WalkFS treats symbolic links.
+type SymlinkPolicy int
+
+const (
+	// SymlinkYield yields symbolic links as entries, without following them.
+	SymlinkYield SymlinkPolicy = iota
+	// SymlinkSkip omits symbolic links from the walk.
+	SymlinkSkip
+	// SymlinkFollow descends into symbolic links to directories as though they
+	// were directories. Links forming a cycle are followed repeatedly, so
+	// following links should be combined with MaxDepth.
+	SymlinkFollow
+)
+
+// dirFrame is a directory being read by a Walker.
+type dirFrame struct {
+	path    string
+	entries []fs.DirEntry
+	i       int
+	// depth is the depth of the entries of the directory.
+	depth int
+}
+
+// Walker is an Iterable over the entries of a file system tree.
+type Walker struct {
+	fsys     fs.FS
+	root     string
+	maxDepth int
+	skip     func(string, fs.DirEntry) bool
+	symlinks SymlinkPolicy
+
+	started bool
+	stack   []dirFrame
+	// pending is a directory to descend into on the next call to Next.
+	pending *WalkEntry
+	// pendingDepth is the depth of pending.
+	pendingDepth int
+}
+
+// WalkFS creates an iterator over the file tree rooted at root, in fsys.
+//
+// Entries are yielded in lexical order, each directory before its contents,
+// starting with root itself, as for fs.WalkDir. Directories are only read once
+// iteration moves past them, so stopping early avoids reading the rest of the
+// tree.
+//
+// Errors do not end the walk. If root cannot be found, a single entry with the
+// error is yielded. If a directory cannot be read, it is yielded a second time,
+// with the error, followed by any entries that could be read.
+func WalkFS(fsys fs.FS, root string) *Walker {
+	return &Walker{fsys: fsys, root: root, maxDepth: -1}
+}
+
+// MaxDepth limits the walk to entries at most depth levels below the root, and
+// returns the iterator. The root is at depth 0, and its contents at depth 1.
+//
+// Panics if depth is negative.
+func (w *Walker) MaxDepth(depth int) *Walker {
+	if depth < 0 {
+		panic("MaxDepth depth must not be negative")
+	}
+
+	w.maxDepth = depth
+	return w
+}
+
+// SkipDir omits the directories for which skip returns true, and their contents,
+// from the walk, and returns the iterator.
+//
+// skip is passed the path of each directory below the root and its entry.
+func (w *Walker) SkipDir(skip func(path string, d fs.DirEntry) bool) *Walker {
+	w.skip = skip
+	return w
+}
+
+// Symlinks sets the treatment of symbolic links, and returns the iterator. The
+// default is SymlinkYield.
+func (w *Walker) Symlinks(policy SymlinkPolicy) *Walker {
+	w.symlinks = policy
+	return w
+}
+
+// Next advances the iterator and returns the next value.
+//
+// Returns nil when iteration is finished.
+func (w *Walker) Next() *WalkEntry {
+	if !w.started {
+		w.started = true
+		return w.walkRoot()
+	}
+
+	if w.pending != nil {
+		dir := w.pending
+		w.pending = nil
+
+		entries, err := fs.ReadDir(w.fsys, dir.Path)
+		w.stack = append(w.stack, dirFrame{dir.Path, entries, 0, w.pendingDepth + 1})
+		if err != nil {
+			return &WalkEntry{dir.Path, dir.Entry, err}
+		}
+	}
+
+	for len(w.stack) > 0 {
+		top := &w.stack[len(w.stack)-1]
+		if top.i >= len(top.entries) {
+			w.stack = w.stack[:len(w.stack)-1]
+			continue
+		}
+
+		entry := top.entries[top.i]
+		top.i += 1
+		next := WalkEntry{path.Join(top.path, entry.Name()), entry, nil}
+
+		isDir := entry.IsDir()
+		if entry.Type()&fs.ModeSymlink != 0 {
+			switch w.symlinks {
+			case SymlinkSkip:
+				continue
+			case SymlinkFollow:
+				info, err := fs.Stat(w.fsys, next.Path)
+				if err != nil {
+					next.Err = err
+					return &next
+				}
+				isDir = info.IsDir()
+			}
+		}
+
+		if isDir {
+			if w.skip != nil && w.skip(next.Path, entry) {
+				continue
+			}
+			w.descend(next, top.depth)
+		}
+
+		return &next
+	}
+
+	return nil
+}
+
+// walkRoot returns the entry for the root of the walk.
+func (w *Walker) walkRoot() *WalkEntry {
+	info, err := fs.Stat(w.fsys, w.root)
+	if err != nil {
+		return &WalkEntry{w.root, nil, err}
+	}
+
+	next := WalkEntry{w.root, fs.FileInfoToDirEntry(info), nil}
+	if info.IsDir() {
+		w.descend(next, 0)
+	}
+
+	return &next
+}
+
+// descend marks a directory at depth to be read on the next call to Next,
+// unless it is at the maximum depth.
+func (w *Walker) descend(dir WalkEntry, depth int) {
+	if w.maxDepth >= 0 && depth >= w.maxDepth {
+		return
+	}
+
+	w.pending = &dir
+	w.pendingDepth = depth
+}
+
+// Fused marks this iterator as a FusedIterable.
+func (w *Walker) Fused() {}
@@ -0,0 +1,177 @@
+package iter_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/partylich/go/iter"
+)
+
+var testFS = fstest.MapFS{
+	"a.txt":             {},
+	"b/c.yaml":          {},
+	"b/d/e.txt":         {},
+	"b/d/f.yaml":        {},
+	".git/config":       {},
+	"z/config.yaml":     {},
+	"z/nested/deep.txt": {},
+}
+
+func walkPaths(w *iter.Walker) []string {
+	var paths []string
+	for e := range w.Seq() {
+		if e.Err != nil {
+			paths = append(paths, e.Path+": "+e.Err.Error())
+			continue
+		}
+		paths = append(paths, e.Path)
+	}
+
+	return paths
+}
+
+func ExampleWalkFS() {
+	isYAML := func(e iter.WalkEntry) bool { return strings.HasSuffix(e.Path, ".yaml") }
+
+	fmt.Println(iter.WalkFS(testFS, ".").Find(isYAML).Path)
+	// Output:
+	// b/c.yaml
+}
+
+func ExampleWalker_SkipDir() {
+	hidden := func(path string, d fs.DirEntry) bool { return strings.HasPrefix(d.Name(), ".") }
+
+	for e := range iter.WalkFS(testFS, ".").SkipDir(hidden).MaxDepth(1).Seq() {
+		fmt.Println(e.Path)
+	}
+	// Output:
+	// .
+	// a.txt
+	// b
+	// z
+}
+
+func TestWalkFS(t *testing.T) {
+	have := walkPaths(iter.WalkFS(testFS, "."))
+
+	var want []string
+	fs.WalkDir(testFS, ".", func(path string, d fs.DirEntry, err error) error {
+		want = append(want, path)
+		return nil
+	})
+
+	if !slices.Equal(have, want) {
+		t.Errorf("paths \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestWalkFS_subdir(t *testing.T) {
+	have := walkPaths(iter.WalkFS(testFS, "b/d"))
+
+	if want := []string{"b/d", "b/d/e.txt", "b/d/f.yaml"}; !slices.Equal(have, want) {
+		t.Errorf("paths \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+func TestWalkFS_file(t *testing.T) {
+	w := iter.WalkFS(testFS, "a.txt")
+
+	e := w.Next()
+	if e == nil || e.Path != "a.txt" || e.Entry.IsDir() {
+		t.Errorf("Next \n\thave %v\n\twant %v", e, "a.txt")
+	}
+	if e := w.Next(); e != nil {
+		t.Errorf("Next \n\thave %v\n\twant <nil>", *e)
+	}
+}
+
+func TestWalkFS_missing(t *testing.T) {
+	have := iter.WalkFS(testFS, "missing").Collect()
+
+	if len(have) != 1 || !errors.Is(have[0].Err, fs.ErrNotExist) || have[0].Entry != nil {
+		t.Errorf("Collect \n\thave %v\n\twant a single %v error", have, fs.ErrNotExist)
+	}
+}
+
+func TestWalkFS_lazy(t *testing.T) {
+	opened := map[string]bool{}
+	fsys := openFS{testFS, opened}
+
+	iter.WalkFS(fsys, ".").Take(3).Collect()
+	if opened["b"] || opened["z"] {
+		t.Errorf("opened \n\thave %v\n\twant only . and .git", opened)
+	}
+}
+
+// openFS records the directories read from a file system.
+type openFS struct {
+	fstest.MapFS
+	opened map[string]bool
+}
+
+func (f openFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.opened[name] = true
+	return f.MapFS.ReadDir(name)
+}
+
+func TestWalkFS_readDirErr(t *testing.T) {
+	errRead := errors.New("read failed")
+	fsys := errFS{testFS, "b", errRead}
+
+	have := walkPaths(iter.WalkFS(fsys, ".").SkipDir(func(path string, d fs.DirEntry) bool {
+		return path == "z"
+	}))
+	want := []string{".", ".git", ".git/config", "a.txt", "b", "b: read failed"}
+	if !slices.Equal(have, want) {
+		t.Errorf("paths \n\thave %v\n\twant %v", have, want)
+	}
+}
+
+// errFS fails to read a single directory.
+type errFS struct {
+	fstest.MapFS
+	dir string
+	err error
+}
+
+func (f errFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name == f.dir {
+		return nil, f.err
+	}
+
+	return f.MapFS.ReadDir(name)
+}
+
+func TestWalkFS_symlinks(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "real"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "real", "file"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("real", filepath.Join(dir, "link")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+	fsys := os.DirFS(dir)
+
+	tests := map[iter.SymlinkPolicy][]string{
+		iter.SymlinkYield:  {".", "link", "real", "real/file"},
+		iter.SymlinkSkip:   {".", "real", "real/file"},
+		iter.SymlinkFollow: {".", "link", "link/file", "real", "real/file"},
+	}
+
+	for policy, want := range tests {
+		have := walkPaths(iter.WalkFS(fsys, ".").Symlinks(policy))
+		if !slices.Equal(have, want) {
+			t.Errorf("Symlinks(%v) \n\thave %v\n\twant %v", policy, have, want)
+		}
+	}
+}